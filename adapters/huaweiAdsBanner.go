@@ -0,0 +1,126 @@
+package adapters
+
+import (
+	"bytes"
+	"errors"
+	"html/template"
+	"net/url"
+	"strings"
+
+	openrtb2 "github.com/prebid/openrtb/v17/openrtb2"
+)
+
+var bannerAdmTemplate = template.Must(template.New("bannerAdm").Parse(`<!DOCTYPE html>` +
+	`<html><head><meta charset="utf-8"><meta name="viewport" content="width=device-width,initial-scale=1">` +
+	`<style>html,body{margin:0;padding:0;overflow:hidden;}` +
+	`#huaweiads{position:relative;width:{{.W}}px;height:{{.H}}px;}` +
+	`#huaweiads img.creative{display:block;width:100%;height:100%;border:0;}</style></head>` +
+	`<body><div id="huaweiads">` +
+	`{{if .ClickUrl}}<a href="{{.ClickUrl}}" target="_blank" onclick="huaweiAdsClick()">{{end}}` +
+	`<img class="creative" src="{{.ImageUrl}}" alt="">{{if .ClickUrl}}</a>{{end}}` +
+	`{{range .ImpTrackers}}<img src="{{.}}" width="1" height="1" style="display:none" alt="">{{end}}` +
+	`</div><script>var huaweiAdsClickTrackers={{.ClickTrackers}};` +
+	`function huaweiAdsClick(){for(var i=0;i<huaweiAdsClickTrackers.length;i++){new Image().src=huaweiAdsClickTrackers[i];}}` +
+	`</script></body></html>`))
+
+type bannerAdmData struct {
+	W             int64
+	H             int64
+	ClickUrl      template.URL
+	ImageUrl      string
+	ImpTrackers   []string
+	ClickTrackers []string
+}
+
+// getBannerAdm: self-contained html for image and gif creatives, sized to the slot
+func getBannerAdm(content *content, imp *openrtb2.Imp) (adm string, w int64, h int64, err error) {
+	image, slotSize, found := getBestMatchedImage(content.MetaData.ImageInfo, getBannerSlotSizes(imp))
+	if !found {
+		return "", 0, 0, errors.New("generate banner adm failed: content has no image, content id: " + content.Contentid)
+	}
+	w, h = image.Width, image.Height
+	if slotSize.W != 0 && slotSize.H != 0 {
+		w, h = slotSize.W, slotSize.H
+	}
+
+	var clickTrackers = getMonitorUrls(content, clickEventType)
+	if clickTrackers == nil {
+		clickTrackers = []string{}
+	}
+	var buffer bytes.Buffer
+	if err = bannerAdmTemplate.Execute(&buffer, bannerAdmData{
+		W:             w,
+		H:             h,
+		ClickUrl:      getBannerClickUrl(content),
+		ImageUrl:      image.Url,
+		ImpTrackers:   getMonitorUrls(content, impEventType),
+		ClickTrackers: clickTrackers,
+	}); err != nil {
+		return "", 0, 0, err
+	}
+	return buffer.String(), w, h, nil
+}
+
+// unsafeUrlSchemes: schemes which run code when the link is clicked
+var unsafeUrlSchemes = map[string]empty{"javascript": {}, "vbscript": {}, "data": {}}
+
+// getBannerClickUrl: html/template replaces non http(s) urls in href, a validated deeplink or intent url is
+// marked as safe. An invalid url leaves the image without link
+func getBannerClickUrl(content *content) template.URL {
+	clickUrl := getClickThroughUrl(content)
+	parsedUrl, err := url.Parse(clickUrl)
+	if err != nil || parsedUrl.Scheme == "" {
+		return ""
+	}
+	if _, unsafe := unsafeUrlSchemes[strings.ToLower(parsedUrl.Scheme)]; unsafe {
+		return ""
+	}
+	return template.URL(clickUrl)
+}
+
+// getBannerSlotSizes: banner W, H first, then banner.Format
+func getBannerSlotSizes(imp *openrtb2.Imp) []format {
+	var sizes []format
+	if imp.Banner == nil {
+		return sizes
+	}
+	if imp.Banner.W != nil && imp.Banner.H != nil && *imp.Banner.W != 0 && *imp.Banner.H != 0 {
+		sizes = append(sizes, format{*imp.Banner.W, *imp.Banner.H})
+	}
+	for _, f := range imp.Banner.Format {
+		if f.W != 0 && f.H != 0 {
+			sizes = append(sizes, format{f.W, f.H})
+		}
+	}
+	return sizes
+}
+
+// getBestMatchedImage: the image whose width and height are closest to one of the slot sizes
+func getBestMatchedImage(images []imageInfo, slotSizes []format) (imageInfo, format, bool) {
+	if len(images) == 0 {
+		return imageInfo{}, format{}, false
+	}
+	if len(slotSizes) == 0 {
+		return images[0], format{}, true
+	}
+
+	var bestImage = images[0]
+	var bestSize = slotSizes[0]
+	var bestDistance int64 = -1
+	for _, image := range images {
+		for _, size := range slotSizes {
+			distance := abs(image.Width-size.W) + abs(image.Height-size.H)
+			if bestDistance < 0 || distance < bestDistance {
+				bestImage, bestSize, bestDistance = image, size, distance
+			}
+		}
+	}
+	return bestImage, bestSize, true
+}
+
+func abs(x int64) int64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,115 @@
+package adapters
+
+import (
+	"html/template"
+	"strings"
+	"testing"
+
+	openrtb2 "github.com/prebid/openrtb/v17/openrtb2"
+)
+
+func TestGetBestMatchedImage(t *testing.T) {
+	var small = imageInfo{Url: "small", Width: 320, Height: 50}
+	var medium = imageInfo{Url: "medium", Width: 300, Height: 250}
+	var large = imageInfo{Url: "large", Width: 1080, Height: 1920}
+	tests := []struct {
+		name      string
+		images    []imageInfo
+		slotSizes []format
+		image     string
+		slotSize  format
+		found     bool
+	}{
+		{name: "no image", slotSizes: []format{{300, 250}}},
+		{name: "no slot size", images: []imageInfo{large, medium}, image: "large", found: true},
+		{name: "exact size", images: []imageInfo{small, medium, large}, slotSizes: []format{{300, 250}}, image: "medium",
+			slotSize: format{300, 250}, found: true},
+		{name: "closest size", images: []imageInfo{small, large}, slotSizes: []format{{300, 250}}, image: "small",
+			slotSize: format{300, 250}, found: true},
+		{name: "best of several slot sizes", images: []imageInfo{large, small}, slotSizes: []format{{300, 250}, {1080, 1920}},
+			image: "large", slotSize: format{1080, 1920}, found: true},
+		{name: "first image on a tie", images: []imageInfo{{Url: "a", Width: 310, Height: 250}, {Url: "b", Width: 290, Height: 250}},
+			slotSizes: []format{{300, 250}}, image: "a", slotSize: format{300, 250}, found: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			image, slotSize, found := getBestMatchedImage(test.images, test.slotSizes)
+			if found != test.found || image.Url != test.image || slotSize != test.slotSize {
+				t.Errorf("getBestMatchedImage = %s, %v, %v, want %s, %v, %v", image.Url, slotSize, found,
+					test.image, test.slotSize, test.found)
+			}
+		})
+	}
+}
+
+func TestGetBannerClickUrl(t *testing.T) {
+	tests := []struct {
+		name     string
+		clickUrl string
+		intent   string
+		want     template.URL
+	}{
+		{name: "https", clickUrl: "https://ads.huawei.com/landing?a=1&b=2", want: "https://ads.huawei.com/landing?a=1&b=2"},
+		{name: "deeplink", clickUrl: "hwappgallery://details?id=com.example", want: "hwappgallery://details?id=com.example"},
+		{name: "intent when click url is empty", intent: "intent://details#Intent;scheme=market;end",
+			want: "intent://details#Intent;scheme=market;end"},
+		{name: "javascript", clickUrl: "javascript:alert(1)"},
+		{name: "upper case javascript", clickUrl: "JavaScript:alert(1)"},
+		{name: "vbscript", clickUrl: "vbscript:msgbox(1)"},
+		{name: "data", clickUrl: "data:text/html;base64,PHNjcmlwdD4="},
+		{name: "no scheme", clickUrl: "ads.huawei.com/landing"},
+		{name: "invalid url", clickUrl: "https://ads.huawei.com/%zz"},
+		{name: "empty"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			content := &content{MetaData: metaData{ClickUrl: test.clickUrl, Intent: test.intent}}
+			if clickUrl := getBannerClickUrl(content); clickUrl != test.want {
+				t.Errorf("getBannerClickUrl = %q, want %q", clickUrl, test.want)
+			}
+		})
+	}
+}
+
+func TestGetBannerAdm(t *testing.T) {
+	var w, h int64 = 320, 50
+	imp := &openrtb2.Imp{ID: "imp1", Banner: &openrtb2.Banner{W: &w, H: &h, Format: []openrtb2.Format{{W: 300, H: 250}}}}
+	content := &content{
+		Contentid: "c1",
+		MetaData: metaData{
+			ClickUrl:  "hwappgallery://details?id=com.example",
+			ImageInfo: []imageInfo{{Url: "https://ads.huawei.com/c1.jpg", Width: 600, Height: 500}},
+		},
+		Monitor: []monitor{
+			{EventType: impEventType, Url: []string{"https://events.huawei.com/imp"}},
+			{EventType: clickEventType, Url: []string{"https://events.huawei.com/click"}},
+		},
+	}
+	adm, w, h, err := getBannerAdm(content, imp)
+	if err != nil {
+		t.Fatalf("getBannerAdm: %v", err)
+	}
+	if w != 300 || h != 250 {
+		t.Errorf("size = %dx%d, want the 300x250 slot", w, h)
+	}
+	for _, want := range []string{`href="hwappgallery://details?id=com.example"`, `src="https://ads.huawei.com/c1.jpg"`,
+		`src="https://events.huawei.com/imp"`, `"https://events.huawei.com/click"`, `width:300px;height:250px;`} {
+		if !strings.Contains(adm, want) {
+			t.Errorf("adm does not contain %s: %s", want, adm)
+		}
+	}
+
+	content.MetaData.ClickUrl = "javascript:alert(1)"
+	adm, _, _, err = getBannerAdm(content, imp)
+	if err != nil {
+		t.Fatalf("getBannerAdm: %v", err)
+	}
+	if strings.Contains(adm, "<a ") || strings.Contains(adm, "javascript:") {
+		t.Errorf("adm links an unsafe url: %s", adm)
+	}
+
+	content.MetaData.ImageInfo = nil
+	if adm, _, _, err := getBannerAdm(content, imp); err == nil {
+		t.Errorf("getBannerAdm without image = %s, want an error", adm)
+	}
+}
@@ -0,0 +1,163 @@
+package adapters
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	openrtb2 "github.com/prebid/openrtb/v17/openrtb2"
+	"github.com/prebid/openrtb/v17/openrtb3"
+)
+
+// used when openrtb BidRequest.TMax is not specified
+const defaultRequestTimeout = 1000 * time.Millisecond
+
+var httpClient = &http.Client{}
+
+type ResponseData struct {
+	StatusCode int
+	Body       []byte
+	Headers    http.Header
+}
+
+type requestResult struct {
+	bidResponse *openrtb2.BidResponse
+	errs        []error
+}
+
+// RequestBids: make one HuaweiAds request per publisher credential, send them in parallel and merge the
+// responses into one openrtb2.BidResponse. Errors of invalid imps come first, the bid response is nil only when
+// no request could be made or sent
+func (a *adapter) RequestBids(ctx context.Context, openRTBRequest *openrtb2.BidRequest) (*openrtb2.BidResponse, []error) {
+	requestData, errs := a.MakeRequests(openRTBRequest)
+	if len(requestData) == 0 {
+		return nil, errs
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, getRequestTimeout(openRTBRequest))
+	defer cancel()
+	var results = make([]requestResult, len(requestData))
+	var wg sync.WaitGroup
+	for i := range requestData {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i].bidResponse, results[i].errs = a.requestGroupBids(ctx, getGroupRequest(openRTBRequest, requestData[i].ImpIDs), requestData[i])
+		}(i)
+	}
+	wg.Wait()
+	bidResponse, responseErrs := mergeBidResponses(openRTBRequest, results)
+	return bidResponse, append(errs, responseErrs...)
+}
+
+// requestGroupBids: send one HuaweiAds request, groupRequest only has the imps of this request
+func (a *adapter) requestGroupBids(ctx context.Context, groupRequest *openrtb2.BidRequest, requestData *RequestData) (*openrtb2.BidResponse, []error) {
+	responseData, err := SendRequest(ctx, requestData)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	if responseData.StatusCode == http.StatusNoContent {
+		return &openrtb2.BidResponse{ID: groupRequest.ID, NBR: NoBidNoFill.Ptr()}, nil
+	}
+	if responseData.StatusCode != http.StatusOK {
+		return &openrtb2.BidResponse{ID: groupRequest.ID, NBR: openrtb3.NoBidTechnicalError.Ptr()},
+			[]error{errors.New("HuaweiAds response: unexpected status code: " + strconv.Itoa(responseData.StatusCode))}
+	}
+	return a.MakeBids(groupRequest, responseData.Body)
+}
+
+// getGroupRequest: a shallow copy of the openrtb request with only the given imps
+func getGroupRequest(openRTBRequest *openrtb2.BidRequest, impIDs []string) *openrtb2.BidRequest {
+	var groupImpIDs = make(map[string]empty, len(impIDs))
+	for _, impID := range impIDs {
+		groupImpIDs[impID] = empty{}
+	}
+	groupRequest := *openRTBRequest
+	groupRequest.Imp = nil
+	for _, imp := range openRTBRequest.Imp {
+		if _, found := groupImpIDs[imp.ID]; found {
+			groupRequest.Imp = append(groupRequest.Imp, imp)
+		}
+	}
+	return &groupRequest
+}
+
+// mergeBidResponses: all bids go to one seatbid. Without bids, NBR is the first reason other than no fill
+func mergeBidResponses(openRTBRequest *openrtb2.BidRequest, results []requestResult) (*openrtb2.BidResponse, []error) {
+	var errs []error
+	var bids []openrtb2.Bid
+	var nbr = NoBidNoFill
+	var responded = false
+	for _, result := range results {
+		errs = append(errs, result.errs...)
+		if result.bidResponse == nil {
+			if nbr == NoBidNoFill {
+				nbr = openrtb3.NoBidTechnicalError
+			}
+			continue
+		}
+		responded = true
+		for _, seatBid := range result.bidResponse.SeatBid {
+			bids = append(bids, seatBid.Bid...)
+		}
+		if result.bidResponse.NBR != nil && *result.bidResponse.NBR != NoBidNoFill && nbr == NoBidNoFill {
+			nbr = *result.bidResponse.NBR
+		}
+	}
+	if !responded {
+		return nil, errs
+	}
+
+	var bidResponse = openrtb2.BidResponse{
+		ID:  openRTBRequest.ID,
+		Cur: getRequestCurrency(openRTBRequest),
+	}
+	if len(bids) > 0 {
+		bidResponse.SeatBid = []openrtb2.SeatBid{{
+			Seat: huaweiAdsSeat,
+			Bid:  bids,
+		}}
+	} else {
+		bidResponse.NBR = nbr.Ptr()
+	}
+	return &bidResponse, errs
+}
+
+// SendRequest: send the signed RequestData to HuaweiAds endpoint and read the response
+func SendRequest(ctx context.Context, requestData *RequestData) (*ResponseData, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, requestData.Method, requestData.Uri, bytes.NewReader(requestData.Body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header = requestData.Headers
+
+	httpResp, err := httpClient.Do(httpReq)
+	if err != nil {
+		return nil, errors.New("send HuaweiAds request failed: " + err.Error())
+	}
+	defer httpResp.Body.Close()
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, errors.New("read HuaweiAds response failed: " + err.Error())
+	}
+	return &ResponseData{
+		StatusCode: httpResp.StatusCode,
+		Body:       body,
+		Headers:    httpResp.Header,
+	}, nil
+}
+
+// getRequestTimeout: BidRequest.TMax is in milliseconds
+func getRequestTimeout(openRTBRequest *openrtb2.BidRequest) time.Duration {
+	if openRTBRequest.TMax > 0 {
+		return time.Duration(openRTBRequest.TMax) * time.Millisecond
+	}
+	return defaultRequestTimeout
+}
@@ -0,0 +1,173 @@
+package adapters_test
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/prebid/openrtb/v17/openrtb2"
+	adapters "main.go/adapters"
+	"main.go/mockserver"
+)
+
+// newMockBidder: start the mock ADX with the fixtures of cmd/huaweiadxmock and point every endpoint at it
+func newMockBidder(t *testing.T, fixtures mockserver.Fixtures) adapters.Bidder {
+	return newMockBidderWithVendorID(t, fixtures, 10)
+}
+
+func newMockBidderWithVendorID(t *testing.T, fixtures mockserver.Fixtures, huaweiVendorID int) adapters.Bidder {
+	server := mockserver.NewTestServer(fixtures)
+	t.Cleanup(server.Close)
+	endpoint := server.URL + mockserver.GetResultPath
+	bidder, err := adapters.Builder(adapters.Config{
+		Endpoint:             endpoint,
+		ChineseSiteEndpoint:  endpoint,
+		EuropeanSiteEndpoint: endpoint,
+		AsianSiteEndpoint:    endpoint,
+		RussianSiteEndpoint:  endpoint,
+		HuaweiVendorID:       huaweiVendorID,
+		CurrencyRatesFile:    "../cmd/huaweiadxmock/rates.json",
+	})
+	if err != nil {
+		t.Fatalf("Builder: %v", err)
+	}
+	return bidder
+}
+
+func loadMockFixtures(t *testing.T) mockserver.Fixtures {
+	fixtures, err := mockserver.LoadFixtures("../cmd/huaweiadxmock/fixtures.json")
+	if err != nil {
+		t.Fatalf("LoadFixtures: %v", err)
+	}
+	return fixtures
+}
+
+func newMockImp(t *testing.T, id string, slotid string, adtype string) openrtb2.Imp {
+	ext, err := json.Marshal(map[string]interface{}{
+		"bidder": map[string]string{
+			"slotid":      slotid,
+			"adtype":      adtype,
+			"publisherid": "123",
+			"signkey":     "signkey",
+			"keyid":       "41",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return openrtb2.Imp{ID: id, Ext: ext}
+}
+
+// newMockRequest: an app request from South Africa with a gaid
+func newMockRequest(imps ...openrtb2.Imp) *openrtb2.BidRequest {
+	return &openrtb2.BidRequest{
+		ID:     "mock-request",
+		Imp:    imps,
+		App:    &openrtb2.App{Bundle: "com.example"},
+		Device: &openrtb2.Device{IP: "1.1.1.1", UA: "Mozilla/5.0", Geo: &openrtb2.Geo{Country: "ZAF"}},
+		User:   &openrtb2.User{Ext: json.RawMessage(`{"data":{"gaid":["8ea5f2b3-4c2d-4e62-9a3b-1f0e6c7d8a90"]}}`)},
+	}
+}
+
+func TestRequestBidsMockServer(t *testing.T) {
+	bidder := newMockBidder(t, loadMockFixtures(t))
+
+	var w, h int64 = 300, 250
+	bannerImp := newMockImp(t, "banner-imp", "u42ohmaufh", "banner")
+	bannerImp.Banner = &openrtb2.Banner{W: &w, H: &h}
+	rollImp := newMockImp(t, "roll-imp", "m8x9x3rzff", "roll")
+	rollImp.Video = &openrtb2.Video{MIMEs: []string{"video/mp4"}, W: 1280, H: 720, MaxDuration: 30}
+	request := newMockRequest(bannerImp, rollImp)
+	request.Cur = []string{"USD"}
+
+	bidResponse, errs := bidder.RequestBids(context.Background(), request)
+	if len(errs) > 0 {
+		t.Fatalf("RequestBids errors: %v", errs)
+	}
+	if bidResponse == nil {
+		t.Fatal("RequestBids returned no bid response")
+	}
+	if bidResponse.Cur != "USD" {
+		t.Errorf("bid response currency = %q, want USD", bidResponse.Cur)
+	}
+
+	// the fixtures price in CNY, rates.json has 7.1 CNY for 1 USD
+	wantPrices := map[string]float64{"banner-imp": 2.8 / 7.1, "roll-imp": 5.6 / 7.1}
+	var bids []openrtb2.Bid
+	for _, seatBid := range bidResponse.SeatBid {
+		bids = append(bids, seatBid.Bid...)
+	}
+	if len(bids) != len(wantPrices) {
+		t.Fatalf("got %d bids, want %d", len(bids), len(wantPrices))
+	}
+	for _, bid := range bids {
+		wantPrice, exists := wantPrices[bid.ImpID]
+		if !exists {
+			t.Errorf("bid for unexpected imp %q", bid.ImpID)
+			continue
+		}
+		delete(wantPrices, bid.ImpID)
+		if math.Abs(bid.Price-wantPrice) > 1e-6 {
+			t.Errorf("imp %s: price = %v, want %v", bid.ImpID, bid.Price, wantPrice)
+		}
+		if bid.AdM == "" {
+			t.Errorf("imp %s: empty adm", bid.ImpID)
+		}
+	}
+}
+
+func TestRequestBidsMockServerRejectsCredential(t *testing.T) {
+	fixtures := loadMockFixtures(t)
+	fixtures.Credentials[0].SignKey = "another signkey"
+	bidder := newMockBidder(t, fixtures)
+
+	var w, h int64 = 300, 250
+	bannerImp := newMockImp(t, "banner-imp", "u42ohmaufh", "banner")
+	bannerImp.Banner = &openrtb2.Banner{W: &w, H: &h}
+	request := newMockRequest(bannerImp)
+
+	bidResponse, errs := bidder.RequestBids(context.Background(), request)
+	if len(errs) != 1 {
+		t.Fatalf("got errors %v, want the rejected signature only", errs)
+	}
+	if !strings.Contains(errs[0].Error(), "retcode: 401") {
+		t.Errorf("error = %q, want the 401 retcode of the mock", errs[0])
+	}
+	if bidResponse != nil {
+		for _, seatBid := range bidResponse.SeatBid {
+			if len(seatBid.Bid) > 0 {
+				t.Errorf("got bids for a rejected signature: %+v", seatBid.Bid)
+			}
+		}
+	}
+}
+
+func TestRequestBidsMockServerWithoutVendorID(t *testing.T) {
+	bidder := newMockBidderWithVendorID(t, loadMockFixtures(t), 0)
+
+	var w, h int64 = 300, 250
+	bannerImp := newMockImp(t, "banner-imp", "u42ohmaufh", "banner")
+	bannerImp.Banner = &openrtb2.Banner{W: &w, H: &h}
+
+	// without GDPR the vendor id is not needed
+	bidResponse, errs := bidder.RequestBids(context.Background(), newMockRequest(bannerImp))
+	if len(errs) > 0 {
+		t.Fatalf("RequestBids errors: %v", errs)
+	}
+	if bidResponse == nil || len(bidResponse.SeatBid) == 0 || len(bidResponse.SeatBid[0].Bid) != 1 {
+		t.Fatalf("got bid response %+v, want one bid", bidResponse)
+	}
+
+	var gdpr int8 = 1
+	request := newMockRequest(bannerImp)
+	request.Regs = &openrtb2.Regs{GDPR: &gdpr}
+	bidResponse, errs = bidder.RequestBids(context.Background(), request)
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "huaweiVendorId is not configured, imp id: banner-imp") {
+		t.Fatalf("got errors %v, want the banner imp skipped for the missing vendor id", errs)
+	}
+	if bidResponse != nil {
+		t.Errorf("got bid response %+v for a skipped imp", bidResponse)
+	}
+}
@@ -0,0 +1,79 @@
+package adapters
+
+import (
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	openrtb2 "github.com/prebid/openrtb/v17/openrtb2"
+	currency "main.go/currency"
+)
+
+// huaweiAdsCurrency: the currency of bid floors sent to huaweiads and of content.Price when content.Cur is empty
+const huaweiAdsCurrency = "CNY"
+
+// openrtbDefaultCurrency: imp.BidFloorCur and BidRequest.Cur default to USD
+const openrtbDefaultCurrency = "USD"
+
+// loadCurrencyConverter: load config.CurrencyRatesFile and refresh it every config.CurrencyRatesRefreshInterval
+func (a *adapter) loadCurrencyConverter(config Config) error {
+	if config.CurrencyRatesFile == "" {
+		log.Println("no currency rates file is configured, only contents priced in the request currency get bids")
+		return nil
+	}
+	converter, err := currency.NewConverter(config.CurrencyRatesFile)
+	if err != nil {
+		return errors.New("load currency rates failed: " + err.Error())
+	}
+	a.currencyConverter = converter
+	if config.CurrencyRatesRefreshInterval == "" {
+		return nil
+	}
+	interval, err := time.ParseDuration(config.CurrencyRatesRefreshInterval)
+	if err != nil || interval <= 0 {
+		return errors.New("invalid currency rates refresh interval: " + config.CurrencyRatesRefreshInterval)
+	}
+	// the adapter lives as long as the process, the refresh is never stopped
+	converter.StartRefresh(interval, func(err error) {
+		log.Println("reload currency rates failed, the previous rates are kept: " + err.Error())
+	})
+	return nil
+}
+
+// convertCurrency: without a rates file, only amounts already in the target currency can be converted
+func (a *adapter) convertCurrency(amount float64, from string, to string) (float64, error) {
+	from = strings.ToUpper(from)
+	to = strings.ToUpper(to)
+	if from == to {
+		return amount, nil
+	}
+	if a.currencyConverter == nil {
+		return 0, errors.New("currency conversion from " + from + " to " + to + " is not supported")
+	}
+	return a.currencyConverter.Convert(amount, from, to)
+}
+
+// getFloorCurrency: the currency of imp.BidFloor
+func getFloorCurrency(bidFloorCur string) string {
+	if bidFloorCur == "" {
+		return openrtbDefaultCurrency
+	}
+	return bidFloorCur
+}
+
+// getContentCurrency: the currency of content.Price
+func getContentCurrency(cur string) string {
+	if cur == "" {
+		return huaweiAdsCurrency
+	}
+	return cur
+}
+
+// getRequestCurrency: bid prices are normalized to the first currency of BidRequest.Cur
+func getRequestCurrency(openRTBRequest *openrtb2.BidRequest) string {
+	if len(openRTBRequest.Cur) == 0 || openRTBRequest.Cur[0] == "" {
+		return openrtbDefaultCurrency
+	}
+	return strings.ToUpper(openRTBRequest.Cur[0])
+}
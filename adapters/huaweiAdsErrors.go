@@ -0,0 +1,118 @@
+package adapters
+
+import (
+	"strconv"
+
+	"github.com/prebid/openrtb/v17/openrtb3"
+)
+
+// RetcodeCategory: how a huaweiads retcode or retcode30 should be handled
+type RetcodeCategory int
+
+const (
+	RetcodeSuccess RetcodeCategory = iota
+	RetcodeNoFill
+	RetcodeBadInput
+	RetcodeServerError
+)
+
+// exchange specific no-bid reasons, openrtb3 reserves 500+ for them
+const (
+	NoBidNoFill                 openrtb3.NoBidReason = 500
+	NoBidSignatureRejected      openrtb3.NoBidReason = 501
+	NoBidSlotNotConfigured      openrtb3.NoBidReason = 502
+	NoBidRateLimitExceeded      openrtb3.NoBidReason = 503
+	NoBidUnsupportedAdType      openrtb3.NoBidReason = 504
+	NoBidMalformedResponse      openrtb3.NoBidReason = 505
+	NoBidCurrencyNotConvertible openrtb3.NoBidReason = 506
+	NoBidUnknownHuaweiError     openrtb3.NoBidReason = 599
+)
+
+type retcodeInfo struct {
+	category    RetcodeCategory
+	nbr         openrtb3.NoBidReason
+	description string
+}
+
+// The retcodes come from the HuaweiAds ADX API 3.4 response (huaweiAdxApiVersion): 200 success, 204 no ads and
+// 206 partial success, which is what the huaweiads adapter of prebid-server
+// (https://github.com/prebid/prebid-server/tree/master/adapters/huaweiads) checks as well. The API reference does
+// not list the other codes one by one, they follow the HTTP status of the same number, so any code missing from
+// the tables is categorized by its range in getRetcodeInfo.
+
+// huaweiAdsRetcodes: huaweiAdsResponse.Retcode
+var huaweiAdsRetcodes = map[int32]retcodeInfo{
+	200: {RetcodeSuccess, openrtb3.NoBidUnknownError, "success"},
+	204: {RetcodeNoFill, NoBidNoFill, "no ads"},
+	206: {RetcodeSuccess, openrtb3.NoBidUnknownError, "partial success, some slots have no ads"},
+	400: {RetcodeBadInput, openrtb3.NoBidInvalidRequest, "invalid request parameters"},
+	401: {RetcodeBadInput, NoBidSignatureRejected, "authorization failed, signature rejected"},
+	403: {RetcodeBadInput, openrtb3.NoBidBlockedPublisher, "publisher or app is not authorized"},
+	404: {RetcodeBadInput, openrtb3.NoBidInvalidRequest, "api not found"},
+	429: {RetcodeServerError, NoBidRateLimitExceeded, "too many requests"},
+	500: {RetcodeServerError, openrtb3.NoBidTechnicalError, "internal server error"},
+	502: {RetcodeServerError, openrtb3.NoBidTechnicalError, "bad gateway"},
+	503: {RetcodeServerError, openrtb3.NoBidTechnicalError, "service unavailable"},
+	504: {RetcodeServerError, openrtb3.NoBidInsufficientTime, "server timeout"},
+}
+
+// huaweiAdsSlotRetcodes: ad30.Retcode30
+var huaweiAdsSlotRetcodes = map[int32]retcodeInfo{
+	200: {RetcodeSuccess, openrtb3.NoBidUnknownError, "success"},
+	204: {RetcodeNoFill, NoBidNoFill, "no ads"},
+	400: {RetcodeBadInput, openrtb3.NoBidInvalidRequest, "invalid slot parameters"},
+	401: {RetcodeBadInput, NoBidSignatureRejected, "slot does not belong to the publisher"},
+	403: {RetcodeBadInput, openrtb3.NoBidBlockedPublisher, "slot is disabled"},
+	404: {RetcodeBadInput, NoBidSlotNotConfigured, "slot not found"},
+	415: {RetcodeBadInput, NoBidUnsupportedAdType, "adtype does not match the slot"},
+	500: {RetcodeServerError, openrtb3.NoBidTechnicalError, "internal server error"},
+	503: {RetcodeServerError, openrtb3.NoBidTechnicalError, "service unavailable"},
+}
+
+// getRetcodeInfo: look up the table first, unknown codes are categorized by their range
+func getRetcodeInfo(retcodes map[int32]retcodeInfo, retcode int32) retcodeInfo {
+	if info, found := retcodes[retcode]; found {
+		return info
+	}
+	switch {
+	case retcode >= 200 && retcode < 300:
+		return retcodeInfo{RetcodeNoFill, NoBidNoFill, "no ads"}
+	case retcode >= 400 && retcode < 500:
+		return retcodeInfo{RetcodeBadInput, openrtb3.NoBidInvalidRequest, "bad input"}
+	case retcode >= 500 && retcode < 600:
+		return retcodeInfo{RetcodeServerError, openrtb3.NoBidTechnicalError, "server error"}
+	default:
+		return retcodeInfo{RetcodeServerError, NoBidUnknownHuaweiError, "unknown retcode"}
+	}
+}
+
+// RetcodeError: a huaweiads retcode (Slotid is empty) or retcode30 which is not a success
+type RetcodeError struct {
+	Retcode  int32
+	Slotid   string
+	Category RetcodeCategory
+	NBR      openrtb3.NoBidReason
+	Reason   string
+}
+
+func (err *RetcodeError) Error() string {
+	var message = "HuaweiAdsResponse retcode: " + strconv.Itoa(int(err.Retcode))
+	if err.Slotid != "" {
+		message = "HuaweiAdsResponse slotid: " + err.Slotid + ", retcode30: " + strconv.Itoa(int(err.Retcode))
+	}
+	return message + ", reason: " + err.Reason
+}
+
+func newRetcodeError(retcodes map[int32]retcodeInfo, retcode int32, slotid string, reason string) *RetcodeError {
+	info := getRetcodeInfo(retcodes, retcode)
+	if reason == "" {
+		reason = info.description
+	}
+	return &RetcodeError{
+		Retcode:  retcode,
+		Slotid:   slotid,
+		Category: info.category,
+		NBR:      info.nbr,
+		Reason:   reason,
+	}
+}
@@ -0,0 +1,72 @@
+package adapters
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prebid/openrtb/v17/openrtb3"
+)
+
+func TestGetRetcodeInfo(t *testing.T) {
+	tests := []struct {
+		name     string
+		retcodes map[int32]retcodeInfo
+		retcode  int32
+		category RetcodeCategory
+		nbr      openrtb3.NoBidReason
+	}{
+		{name: "success", retcodes: huaweiAdsRetcodes, retcode: 200, category: RetcodeSuccess, nbr: openrtb3.NoBidUnknownError},
+		{name: "partial success", retcodes: huaweiAdsRetcodes, retcode: 206, category: RetcodeSuccess, nbr: openrtb3.NoBidUnknownError},
+		{name: "no ads", retcodes: huaweiAdsRetcodes, retcode: 204, category: RetcodeNoFill, nbr: NoBidNoFill},
+		{name: "invalid request", retcodes: huaweiAdsRetcodes, retcode: 400, category: RetcodeBadInput, nbr: openrtb3.NoBidInvalidRequest},
+		{name: "signature rejected", retcodes: huaweiAdsRetcodes, retcode: 401, category: RetcodeBadInput, nbr: NoBidSignatureRejected},
+		{name: "publisher blocked", retcodes: huaweiAdsRetcodes, retcode: 403, category: RetcodeBadInput, nbr: openrtb3.NoBidBlockedPublisher},
+		{name: "rate limit", retcodes: huaweiAdsRetcodes, retcode: 429, category: RetcodeServerError, nbr: NoBidRateLimitExceeded},
+		{name: "server error", retcodes: huaweiAdsRetcodes, retcode: 503, category: RetcodeServerError, nbr: openrtb3.NoBidTechnicalError},
+		{name: "server timeout", retcodes: huaweiAdsRetcodes, retcode: 504, category: RetcodeServerError, nbr: openrtb3.NoBidInsufficientTime},
+		{name: "slot success", retcodes: huaweiAdsSlotRetcodes, retcode: 200, category: RetcodeSuccess, nbr: openrtb3.NoBidUnknownError},
+		{name: "slot no ads", retcodes: huaweiAdsSlotRetcodes, retcode: 204, category: RetcodeNoFill, nbr: NoBidNoFill},
+		{name: "slot not found", retcodes: huaweiAdsSlotRetcodes, retcode: 404, category: RetcodeBadInput, nbr: NoBidSlotNotConfigured},
+		{name: "slot adtype", retcodes: huaweiAdsSlotRetcodes, retcode: 415, category: RetcodeBadInput, nbr: NoBidUnsupportedAdType},
+		{name: "slot server error", retcodes: huaweiAdsSlotRetcodes, retcode: 500, category: RetcodeServerError, nbr: openrtb3.NoBidTechnicalError},
+		{name: "unlisted 2xx", retcodes: huaweiAdsRetcodes, retcode: 299, category: RetcodeNoFill, nbr: NoBidNoFill},
+		{name: "unlisted slot 206", retcodes: huaweiAdsSlotRetcodes, retcode: 206, category: RetcodeNoFill, nbr: NoBidNoFill},
+		{name: "unlisted 4xx", retcodes: huaweiAdsRetcodes, retcode: 418, category: RetcodeBadInput, nbr: openrtb3.NoBidInvalidRequest},
+		{name: "unlisted 5xx", retcodes: huaweiAdsSlotRetcodes, retcode: 599, category: RetcodeServerError, nbr: openrtb3.NoBidTechnicalError},
+		{name: "unknown 3xx", retcodes: huaweiAdsRetcodes, retcode: 302, category: RetcodeServerError, nbr: NoBidUnknownHuaweiError},
+		{name: "unknown 600", retcodes: huaweiAdsRetcodes, retcode: 600, category: RetcodeServerError, nbr: NoBidUnknownHuaweiError},
+		{name: "unknown 0", retcodes: huaweiAdsSlotRetcodes, retcode: 0, category: RetcodeServerError, nbr: NoBidUnknownHuaweiError},
+		{name: "unknown negative", retcodes: huaweiAdsRetcodes, retcode: -1, category: RetcodeServerError, nbr: NoBidUnknownHuaweiError},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			info := getRetcodeInfo(test.retcodes, test.retcode)
+			if info.category != test.category || info.nbr != test.nbr {
+				t.Errorf("getRetcodeInfo(%d) = category %d, nbr %d, want category %d, nbr %d", test.retcode,
+					info.category, info.nbr, test.category, test.nbr)
+			}
+			if info.description == "" {
+				t.Errorf("getRetcodeInfo(%d) has no description", test.retcode)
+			}
+		})
+	}
+}
+
+func TestNewRetcodeError(t *testing.T) {
+	err := newRetcodeError(huaweiAdsRetcodes, 401, "", "")
+	if err.Category != RetcodeBadInput || err.NBR != NoBidSignatureRejected {
+		t.Errorf("retcode 401 = category %d, nbr %d", err.Category, err.NBR)
+	}
+	if want := "HuaweiAdsResponse retcode: 401, reason: authorization failed, signature rejected"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+
+	err = newRetcodeError(huaweiAdsSlotRetcodes, 404, "u42ohmaufh", "slot u42ohmaufh is offline")
+	if err.NBR != NoBidSlotNotConfigured {
+		t.Errorf("retcode30 404 nbr = %d, want %d", err.NBR, NoBidSlotNotConfigured)
+	}
+	if !strings.HasPrefix(err.Error(), "HuaweiAdsResponse slotid: u42ohmaufh, retcode30: 404") ||
+		!strings.HasSuffix(err.Error(), "slot u42ohmaufh is offline") {
+		t.Errorf("Error() = %q, want the slotid, retcode30 and the huaweiads reason", err.Error())
+	}
+}
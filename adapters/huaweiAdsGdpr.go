@@ -0,0 +1,132 @@
+package adapters
+
+import (
+	"encoding/json"
+	"log"
+	"strconv"
+	"strings"
+
+	openrtb2 "github.com/prebid/openrtb/v17/openrtb2"
+	"main.go/tcf2"
+)
+
+// TCF v2 purposes checked before sending a request to huaweiads
+const (
+	purposeStoreAccessDevice   = 1
+	purposeBasicAds            = 2
+	purposePersonalisedProfile = 3
+	purposePersonalisedAds     = 4
+)
+
+// gdprDecision: what the consent allows the adapter to send
+type gdprDecision int
+
+const (
+	gdprNotApplicable gdprDecision = iota
+	gdprSendPersonalData
+	gdprStripPersonalData
+	gdprSkipImps
+)
+
+var gdprDecisionNames = map[gdprDecision]string{
+	gdprNotApplicable:     "not applicable",
+	gdprSendPersonalData:  "send personal data",
+	gdprStripPersonalData: "strip personal data",
+	gdprSkipImps:          "skip imps",
+}
+
+// getGdprDecision: without a valid consent for the huaweiads vendor and basic ads, the imps are skipped.
+// Device ids, ip and precise geo are only sent with consent to storage access and personalised ads
+func (a *adapter) getGdprDecision(openRTBRequest *openrtb2.BidRequest) (gdprDecision, string) {
+	if !isGdprApplies(openRTBRequest) {
+		return gdprNotApplicable, "gdpr does not apply"
+	}
+	if a.huaweiVendorID <= 0 {
+		return gdprSkipImps, "huaweiVendorId is not configured"
+	}
+	consentString := getConsentString(openRTBRequest)
+	if consentString == "" {
+		return gdprSkipImps, "consent string is missing"
+	}
+	consent, err := tcf2.Parse(consentString)
+	if err != nil {
+		return gdprSkipImps, err.Error()
+	}
+
+	vendorConsent := consent.VendorConsent(a.huaweiVendorID)
+	vendorLI := consent.VendorLegitimateInterest(a.huaweiVendorID)
+	if !vendorConsent && !vendorLI {
+		return gdprSkipImps, "vendor " + strconv.Itoa(a.huaweiVendorID) + " has neither consent nor legitimate interest"
+	}
+	if !consent.PurposeConsent(purposeBasicAds) && !(vendorLI && consent.PurposeLITransparency(purposeBasicAds)) {
+		return gdprSkipImps, "no legal basis for purpose " + strconv.Itoa(purposeBasicAds)
+	}
+
+	var missing []string
+	if !vendorConsent {
+		missing = append(missing, "vendor "+strconv.Itoa(a.huaweiVendorID))
+	}
+	for _, purpose := range []int{purposeStoreAccessDevice, purposePersonalisedProfile, purposePersonalisedAds} {
+		if !consent.PurposeConsent(purpose) {
+			missing = append(missing, "purpose "+strconv.Itoa(purpose))
+		}
+	}
+	if len(missing) > 0 {
+		return gdprStripPersonalData, "no consent for " + strings.Join(missing, ", ")
+	}
+	return gdprSendPersonalData, "consent for vendor and purposes 1, 3, 4"
+}
+
+// logGdprDecision: one line per request for audits
+func logGdprDecision(openRTBRequest *openrtb2.BidRequest, decision gdprDecision, reason string) {
+	if decision == gdprNotApplicable {
+		return
+	}
+	log.Println("GDPR audit: request id: " + openRTBRequest.ID + ", decision: " + gdprDecisionNames[decision] +
+		", reason: " + reason)
+}
+
+// isGdprApplies: regs.gdpr first, then regs.ext.gdpr
+func isGdprApplies(openRTBRequest *openrtb2.BidRequest) bool {
+	if openRTBRequest.Regs == nil {
+		return false
+	}
+	if openRTBRequest.Regs.GDPR != nil {
+		return *openRTBRequest.Regs.GDPR == 1
+	}
+	if openRTBRequest.Regs.Ext != nil {
+		var extRegs ExtRegs
+		if err := json.Unmarshal(openRTBRequest.Regs.Ext, &extRegs); err == nil && extRegs.GDPR != nil {
+			return *extRegs.GDPR == 1
+		}
+	}
+	return false
+}
+
+// getConsentString: user.consent first, then user.ext.consent
+func getConsentString(openRTBRequest *openrtb2.BidRequest) string {
+	if openRTBRequest.User == nil {
+		return ""
+	}
+	if openRTBRequest.User.Consent != "" {
+		return openRTBRequest.User.Consent
+	}
+	if openRTBRequest.User.Ext != nil {
+		var extUser ExtUser
+		if err := json.Unmarshal(openRTBRequest.User.Ext, &extUser); err == nil {
+			return extUser.Consent
+		}
+	}
+	return ""
+}
+
+// stripPersonalData: remove device ids, ip and precise geo from the HuaweiAds request
+func stripPersonalData(request *HuaweiAdsRequest) {
+	request.Device.Imei = ""
+	request.Device.Oaid = ""
+	request.Device.Gaid = ""
+	request.Device.IsTrackingEnabled = ""
+	request.Device.GaidTrackingEnabled = ""
+	request.Device.Ip = ""
+	request.Geo = geo{}
+}
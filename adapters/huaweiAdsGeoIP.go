@@ -0,0 +1,63 @@
+package adapters
+
+import (
+	"errors"
+	"log"
+	"net"
+
+	maxminddb "github.com/oschwald/maxminddb-golang"
+	openrtb2 "github.com/prebid/openrtb/v17/openrtb2"
+	constants "main.go/utils"
+)
+
+// geoIPRecord: the country fields of GeoLite2 / GeoIP2 Country and City databases
+type geoIPRecord struct {
+	Country struct {
+		IsoCode string `maxminddb:"iso_code"`
+	} `maxminddb:"country"`
+	RegisteredCountry struct {
+		IsoCode string `maxminddb:"iso_code"`
+	} `maxminddb:"registered_country"`
+}
+
+// loadGeoIPDatabase: open config.GeoIPDatabaseFile, the database is optional
+func (a *adapter) loadGeoIPDatabase(config Config) error {
+	if config.GeoIPDatabaseFile == "" {
+		return nil
+	}
+	reader, err := maxminddb.Open(config.GeoIPDatabaseFile)
+	if err != nil {
+		return errors.New("open GeoIP database failed: " + err.Error())
+	}
+	a.geoIPReader = reader
+	return nil
+}
+
+// getCountryCodeFromIP: device.IP first, then device.IPv6. The country of the network is used when the
+// database has no country for the ip, like anonymous proxies
+func (a *adapter) getCountryCodeFromIP(device *openrtb2.Device) (string, bool) {
+	if a.geoIPReader == nil || device == nil {
+		return "", false
+	}
+	for _, ip := range []string{device.IP, device.IPv6} {
+		if ip == "" {
+			continue
+		}
+		parsedIP := net.ParseIP(ip)
+		if parsedIP == nil {
+			log.Println("GeoIP lookup skipped: invalid ip " + ip)
+			continue
+		}
+		var record geoIPRecord
+		if err := a.geoIPReader.Lookup(parsedIP, &record); err != nil {
+			log.Println("GeoIP lookup of " + ip + " failed: " + err.Error())
+			continue
+		}
+		for _, isoCode := range []string{record.Country.IsoCode, record.RegisteredCountry.IsoCode} {
+			if countryCode, valid := constants.ToCountryAlpha2(isoCode); valid {
+				return countryCode, true
+			}
+		}
+	}
+	return "", false
+}
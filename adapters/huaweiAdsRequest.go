@@ -1,6 +1,7 @@
 package adapters
 
 import (
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
@@ -8,14 +9,18 @@ import (
 	"errors"
 	"log"
 	"net/http"
+	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
+	maxminddb "github.com/oschwald/maxminddb-golang"
+	"github.com/prebid/openrtb/v17/adcom1"
 	"github.com/prebid/openrtb/v17/native1"
 	nativeRequests "github.com/prebid/openrtb/v17/native1/request"
 	openrtb2 "github.com/prebid/openrtb/v17/openrtb2"
+	currency "main.go/currency"
 	constants "main.go/utils"
 )
 
@@ -33,6 +38,12 @@ const (
 	videoWithPicturesText  int32 = 11
 )
 
+// orientation
+const (
+	landscape int32 = 0
+	portrait  int32 = 1
+)
+
 // interaction type
 const (
 	appPromotion int32 = 3
@@ -61,6 +72,7 @@ const chineseSiteEndPoint = "https://acd.op.hicloud.com/ppsadx/getResult"
 const europeanSiteEndPoint = "https://adx-dre.op.hicloud.com/ppsadx/getResult"
 const asianSiteEndPoint = "https://adx-dra.op.hicloud.com/ppsadx/getResult"
 const russianSiteEndPoint = "https://adx-drru.op.hicloud.com/ppsadx/getResult"
+const closeSiteSelectionByCountry = "1"
 
 type HuaweiAdsRequest struct {
 	Version           string     `json:"version"`
@@ -84,6 +96,8 @@ type adslot30 struct {
 	H                        int64    `json:"h,omitempty"`
 	Format                   []format `json:"format,omitempty"`
 	DetailedCreativeTypeList []string `json:"detailedCreativeTypeList,omitempty"`
+	BidFloor                 float64  `json:"bidFloor,omitempty"`
+	BidFloorCur              string   `json:"bidFloorCur,omitempty"`
 }
 
 type format struct {
@@ -239,6 +253,47 @@ type monitor struct {
 type adapter struct {
 	endpoint  string
 	extraInfo ExtraInfo
+	// country based site endpoints
+	chineseSiteEndpoint  string
+	europeanSiteEndpoint string
+	asianSiteEndpoint    string
+	russianSiteEndpoint  string
+	// IAB global vendor list id of huaweiads, 0 means the imps of GDPR requests are skipped
+	huaweiVendorID int
+	// used when the country can't be found in the request or the GeoIP database
+	defaultCountry string
+	// nil when no GeoIP database is configured
+	geoIPReader *maxminddb.Reader
+	// nil when no rates file is configured, only same currency amounts can be converted then
+	currencyConverter *currency.Converter
+}
+
+// Config: HuaweiAds adapter config, empty endpoints fall back to the production hosts
+type Config struct {
+	Endpoint             string `json:"endpoint,omitempty"`
+	ChineseSiteEndpoint  string `json:"chineseSiteEndpoint,omitempty"`
+	EuropeanSiteEndpoint string `json:"europeanSiteEndpoint,omitempty"`
+	AsianSiteEndpoint    string `json:"asianSiteEndpoint,omitempty"`
+	RussianSiteEndpoint  string `json:"russianSiteEndpoint,omitempty"`
+	// ExtraInfo is the JSON string of ExtraInfo
+	ExtraInfo string `json:"extraInfo,omitempty"`
+	// HuaweiVendorID is the IAB global vendor list id checked in TCF v2 consent strings, without it the imps of
+	// requests where GDPR applies are skipped
+	HuaweiVendorID int `json:"huaweiVendorId,omitempty"`
+	// GeoIPDatabaseFile is a MaxMind format (mmdb) country database, like GeoLite2-Country.mmdb
+	GeoIPDatabaseFile string `json:"geoIPDatabaseFile,omitempty"`
+	// DefaultCountry is an ISO 3166-1 alpha-2 or alpha-3 code, empty means ZA
+	DefaultCountry string `json:"defaultCountry,omitempty"`
+	// CurrencyRatesFile is a JSON rates file {"dataAsOf": "...", "conversions": {"USD": {"CNY": 7.1}}}
+	CurrencyRatesFile string `json:"currencyRatesFile,omitempty"`
+	// CurrencyRatesRefreshInterval is a duration like "30m", empty means the rates file is loaded once
+	CurrencyRatesRefreshInterval string `json:"currencyRatesRefreshInterval,omitempty"`
+}
+
+type Bidder interface {
+	MakeRequests(openRTBRequest *openrtb2.BidRequest) ([]*RequestData, []error)
+	MakeBids(openRTBRequest *openrtb2.BidRequest, responseBody []byte) (*openrtb2.BidResponse, []error)
+	RequestBids(ctx context.Context, openRTBRequest *openrtb2.BidRequest) (*openrtb2.BidResponse, []error)
 }
 
 type ExtraInfo struct {
@@ -289,6 +344,14 @@ type ExtUser struct {
 	Eids                             []openrtb2.EID                 `json:"eids,omitempty"`
 }
 
+type ExtRegs struct {
+	// GDPR should be "1" if the caller believes the user is subject to GDPR laws, "0" if not, and undefined
+	// if it's unknown. For more info on this parameter, see: https://iabtechlab.com/wp-content/uploads/2018/02/OpenRTB_Advisory_GDPR_2018-02.pdf
+	GDPR *int8 `json:"gdpr,omitempty"`
+	// USPrivacy is the CCPA string, openrtb 2.6 moves it to regs.us_privacy
+	USPrivacy string `json:"us_privacy,omitempty"`
+}
+
 type ConsentedProvidersSettingsIn struct {
 	ConsentedProvidersString string `json:"consented_providers,omitempty"`
 }
@@ -304,91 +367,204 @@ type RequestData struct {
 	Uri     string
 	Body    []byte
 	Headers http.Header
+	// ImpIDs are the openrtb imps whose slots are in Body
+	ImpIDs []string
 }
 
-func MakeRequest(openRTBRequest *openrtb2.BidRequest) (*HuaweiAdsRequest, error) {
-	var huaweiAdsRequest HuaweiAdsRequest
-	var multislot []adslot30
-	var publishersCredential *PublishersCredential
-	for _, imp := range openRTBRequest.Imp {
-		var err error
-		publishersCredential, err = GetPublishersCredentials(&imp)
-		if err != nil {
-			return nil, err
+// Builder: build a reusable HuaweiAds adapter from config
+func Builder(config Config) (Bidder, error) {
+	var extraInfo ExtraInfo
+	if config.ExtraInfo != "" {
+		if err := json.Unmarshal([]byte(config.ExtraInfo), &extraInfo); err != nil {
+			return nil, errors.New("invalid extra info: " + err.Error())
+		}
+	}
+	if config.HuaweiVendorID <= 0 {
+		log.Println("huaweiVendorId is not configured, imps of requests where GDPR applies are skipped")
+	}
+	bidder := &adapter{
+		endpoint:             getConfigEndpoint(config.Endpoint, defaultEndpoint),
+		extraInfo:            extraInfo,
+		chineseSiteEndpoint:  getConfigEndpoint(config.ChineseSiteEndpoint, chineseSiteEndPoint),
+		europeanSiteEndpoint: getConfigEndpoint(config.EuropeanSiteEndpoint, europeanSiteEndPoint),
+		asianSiteEndpoint:    getConfigEndpoint(config.AsianSiteEndpoint, asianSiteEndPoint),
+		russianSiteEndpoint:  getConfigEndpoint(config.RussianSiteEndpoint, russianSiteEndPoint),
+		huaweiVendorID:       config.HuaweiVendorID,
+	}
+	for _, endpoint := range []string{bidder.endpoint, bidder.chineseSiteEndpoint, bidder.europeanSiteEndpoint,
+		bidder.asianSiteEndpoint, bidder.russianSiteEndpoint} {
+		if _, err := url.ParseRequestURI(endpoint); err != nil {
+			return nil, errors.New("invalid endpoint " + endpoint + ": " + err.Error())
+		}
+	}
+	if config.DefaultCountry == "" {
+		bidder.defaultCountry = defaultCountryName
+	} else if defaultCountry, valid := constants.ToCountryAlpha2(config.DefaultCountry); valid {
+		bidder.defaultCountry = defaultCountry
+	} else {
+		return nil, errors.New("invalid default country " + config.DefaultCountry + ": not an ISO 3166-1 code")
+	}
+	if err := bidder.loadGeoIPDatabase(config); err != nil {
+		return nil, err
+	}
+	if err := bidder.loadCurrencyConverter(config); err != nil {
+		return nil, err
+	}
+	return bidder, nil
+}
+
+func getConfigEndpoint(endpoint string, defaultValue string) string {
+	if endpoint == "" {
+		return defaultValue
+	}
+	return endpoint
+}
+
+// credentialGroupKey: imps are signed by publisherid, signkey and keyid, test authorization changes the nonce
+type credentialGroupKey struct {
+	publisherId         string
+	signKey             string
+	keyId               string
+	isTestAuthorization bool
+}
+
+type credentialGroup struct {
+	publishersCredential *PublishersCredential
+	multislot            []adslot30
+	impIDs               []string
+}
+
+// MakeRequests: translate openrtb2.BidRequest into one signed HuaweiAds request per publisher credential,
+// the groups keep the order in which their first imp appears. An invalid imp is reported in the error list
+// and left out, the valid imps are still sent
+func (a *adapter) MakeRequests(openRTBRequest *openrtb2.BidRequest) ([]*RequestData, []error) {
+	if len(openRTBRequest.Imp) == 0 {
+		return nil, []error{errors.New("openrtb BidRequest has no imp, request id: " + openRTBRequest.ID)}
+	}
+	var errs []error
+	gdprDecision, gdprReason := a.getGdprDecision(openRTBRequest)
+	logGdprDecision(openRTBRequest, gdprDecision, gdprReason)
+	if gdprDecision == gdprSkipImps {
+		for _, imp := range openRTBRequest.Imp {
+			errs = append(errs, errors.New("imp skipped by GDPR enforcement: "+gdprReason+", imp id: "+imp.ID))
 		}
+		return nil, errs
+	}
 
-		if publishersCredential == nil {
-			return nil, errors.New("publishers credentials is not complete!")
+	var groups []*credentialGroup
+	var groupIndex = make(map[credentialGroupKey]*credentialGroup)
+	for _, imp := range openRTBRequest.Imp {
+		publishersCredential, err := GetPublishersCredentials(&imp)
+		if err != nil {
+			errs = append(errs, err)
+			continue
 		}
 
-		adslot30, err1 := getReqAdslot30(publishersCredential, &imp)
+		adslot30, err := a.getReqAdslot30(publishersCredential, &imp)
 		if err != nil {
-			return nil, err1
+			errs = append(errs, errors.New(err.Error()+", imp id: "+imp.ID))
+			continue
 		}
 
-		multislot = append(multislot, adslot30)
+		key := credentialGroupKey{
+			publisherId:         publishersCredential.PublisherId,
+			signKey:             publishersCredential.SignKey,
+			keyId:               publishersCredential.KeyId,
+			isTestAuthorization: publishersCredential.IsTestAuthorization == "true",
+		}
+		group, exists := groupIndex[key]
+		if !exists {
+			group = &credentialGroup{publishersCredential: publishersCredential}
+			groupIndex[key] = group
+			groups = append(groups, group)
+		}
+		group.multislot = append(group.multislot, adslot30)
+		group.impIDs = append(group.impIDs, imp.ID)
 	}
-	huaweiAdsRequest.Multislot = multislot
+	if len(groups) == 0 {
+		return nil, errs
+	}
+
+	// app, device, network, regs, geo and consent are the same for every group
+	var huaweiAdsRequest HuaweiAdsRequest
 	huaweiAdsRequest.ClientAdRequestId = openRTBRequest.ID
-	countryCode, err := getReqJson(&huaweiAdsRequest, openRTBRequest)
+	usPrivacyOptOut, usPrivacyReason := getUsPrivacyOptOut(openRTBRequest)
+	if usPrivacyOptOut {
+		logUsPrivacyOptOut(openRTBRequest, usPrivacyReason)
+	}
+	personalDataAllowed := gdprDecision != gdprStripPersonalData && !usPrivacyOptOut
+	countryCode, err := a.getReqJson(&huaweiAdsRequest, openRTBRequest, personalDataAllowed)
 	if err != nil {
-		return nil, err
+		return nil, append(errs, err)
 	}
+
+	var requestData []*RequestData
+	for _, group := range groups {
+		bidRequest, err := a.makeGroupRequest(openRTBRequest, huaweiAdsRequest, countryCode, group)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		requestData = append(requestData, bidRequest)
+	}
+	return requestData, errs
+}
+
+// makeGroupRequest: a HuaweiAds request with the slots of one credential group, signed with its credential
+func (a *adapter) makeGroupRequest(openRTBRequest *openrtb2.BidRequest, huaweiAdsRequest HuaweiAdsRequest,
+	countryCode string, group *credentialGroup) (*RequestData, error) {
+	huaweiAdsRequest.Multislot = group.multislot
 	reqJSON, err := json.Marshal(huaweiAdsRequest)
 	if err != nil {
 		return nil, err
 	}
-	var isTestAuthorization = false
-	if publishersCredential != nil && publishersCredential.IsTestAuthorization == "true" {
-		isTestAuthorization = true
-	}
-	header := getHeaders(publishersCredential, openRTBRequest, isTestAuthorization)
-	bidRequest := RequestData{
+	var isTestAuthorization = group.publishersCredential.IsTestAuthorization == "true"
+	header := getHeaders(group.publishersCredential, openRTBRequest, isTestAuthorization)
+	return &RequestData{
 		Method:  http.MethodPost,
-		Uri:     getFinalEndPoint(countryCode),
+		Uri:     a.getFinalEndPoint(countryCode),
 		Body:    reqJSON,
 		Headers: header,
-	}
-
-	log.Println(bidRequest)
-	return &huaweiAdsRequest, nil
+		ImpIDs:  group.impIDs,
+	}, nil
 }
 
+// GetPublishersCredentials: parse the huaweiads bidder params from imp.ext.bidder
 func GetPublishersCredentials(openRTBImp *openrtb2.Imp) (*PublishersCredential, error) {
-	// var bidderExt ExtImpBidder
-	huaweiAdsImpExt := PublishersCredential{
-		SlotId:      "1",
-		Adtype:      "2",
-		PublisherId: "3",
-		SignKey:     "4",
-		KeyId:       "5",
-	}
-
-	// if err := json.Unmarshal(openRTBImp.Ext, &bidderExt); err != nil {
-	// 	return nil, errors.New("Unmarshal: openRTBImp.Ext -> bidderExt failed")
-	// }
-	// if err := json.Unmarshal(bidderExt.Bidder, &huaweiAdsImpExt); err != nil {
-	// 	return nil, errors.New("Unmarshal: bidderExt.Bidder -> huaweiAdsImpExt failed")
-	// }
-	// if huaweiAdsImpExt.SlotId == "" {
-	// 	return nil, errors.New("ExtImpHuaweiAds: slotid is empty.")
-	// }
-	// if huaweiAdsImpExt.Adtype == "" {
-	// 	return nil, errors.New("ExtImpHuaweiAds: adtype is empty.")
-	// }
-	// if huaweiAdsImpExt.PublisherId == "" {
-	// 	return nil, errors.New("ExtHuaweiAds: publisherid is empty.")
-	// }
-	// if huaweiAdsImpExt.SignKey == "" {
-	// 	return nil, errors.New("ExtHuaweiAds: signkey is empty.")
-	// }
-	// if huaweiAdsImpExt.KeyId == "" {
-	// 	return nil, errors.New("ExtImpHuaweiAds: keyid is empty.")
-	// }
+	var bidderExt ExtImpBidder
+	var huaweiAdsImpExt PublishersCredential
+
+	if openRTBImp.Ext == nil {
+		return nil, errors.New("ExtImpHuaweiAds: imp.ext is empty, imp id: " + openRTBImp.ID)
+	}
+	if err := json.Unmarshal(openRTBImp.Ext, &bidderExt); err != nil {
+		return nil, errors.New("Unmarshal: openRTBImp.Ext -> bidderExt failed, imp id: " + openRTBImp.ID + ", error: " + err.Error())
+	}
+	if bidderExt.Bidder == nil {
+		return nil, errors.New("ExtImpHuaweiAds: imp.ext.bidder is empty, imp id: " + openRTBImp.ID)
+	}
+	if err := json.Unmarshal(bidderExt.Bidder, &huaweiAdsImpExt); err != nil {
+		return nil, errors.New("Unmarshal: bidderExt.Bidder -> huaweiAdsImpExt failed, imp id: " + openRTBImp.ID + ", error: " + err.Error())
+	}
+	if huaweiAdsImpExt.SlotId == "" {
+		return nil, errors.New("ExtImpHuaweiAds: slotid is empty, imp id: " + openRTBImp.ID)
+	}
+	if huaweiAdsImpExt.Adtype == "" {
+		return nil, errors.New("ExtImpHuaweiAds: adtype is empty, imp id: " + openRTBImp.ID)
+	}
+	if huaweiAdsImpExt.PublisherId == "" {
+		return nil, errors.New("ExtImpHuaweiAds: publisherid is empty, imp id: " + openRTBImp.ID)
+	}
+	if huaweiAdsImpExt.SignKey == "" {
+		return nil, errors.New("ExtImpHuaweiAds: signkey is empty, imp id: " + openRTBImp.ID)
+	}
+	if huaweiAdsImpExt.KeyId == "" {
+		return nil, errors.New("ExtImpHuaweiAds: keyid is empty, imp id: " + openRTBImp.ID)
+	}
 	return &huaweiAdsImpExt, nil
 }
 
-func getReqAdslot30(publishersCredential *PublishersCredential, openRTBImp *openrtb2.Imp) (adslot30, error) {
+func (a *adapter) getReqAdslot30(publishersCredential *PublishersCredential, openRTBImp *openrtb2.Imp) (adslot30, error) {
 	adtype := GetAdtype(publishersCredential.Adtype)
 	testStatus := GetTestStatus(publishersCredential.IsTestAuthorization)
 	var adslot30 = adslot30{
@@ -399,9 +575,24 @@ func getReqAdslot30(publishersCredential *PublishersCredential, openRTBImp *open
 	if err := checkAndExtractOpenrtbFormat(&adslot30, adtype, publishersCredential.Adtype, openRTBImp); err != nil {
 		return adslot30, err
 	}
+	a.getBidFloor(&adslot30, openRTBImp)
 	return adslot30, nil
 }
 
+// getBidFloor: forward imp.BidFloor in the currency huaweiads expects, a floor that can't be converted is not sent
+func (a *adapter) getBidFloor(adslot30 *adslot30, openRTBImp *openrtb2.Imp) {
+	if openRTBImp.BidFloor <= 0 {
+		return
+	}
+	bidFloor, err := a.convertCurrency(openRTBImp.BidFloor, getFloorCurrency(openRTBImp.BidFloorCur), huaweiAdsCurrency)
+	if err != nil {
+		log.Println("bid floor of imp " + openRTBImp.ID + " is not forwarded: " + err.Error())
+		return
+	}
+	adslot30.BidFloor = bidFloor
+	adslot30.BidFloorCur = huaweiAdsCurrency
+}
+
 func GetAdtype(adtype string) int32 {
 	switch strings.ToLower(adtype) {
 	case "banner":
@@ -432,55 +623,108 @@ func GetTestStatus(testStatus string) int32 {
 	return 0
 }
 
+// checkAndExtractOpenrtbFormat: every format offered by the imp is checked against the huawei adtype, the
+// matched ones are combined into one adslot30 and the others are ignored
 func checkAndExtractOpenrtbFormat(adslot30 *adslot30, adtype int32, yourAdtype string, openRTBImp *openrtb2.Imp) error {
-	log.Println("a")
-	if openRTBImp.Banner != nil {
-		log.Println("b")
-		if adtype != banner && adtype != interstitial {
-			return errors.New("check openrtb format: request has banner, doesn't correspond to huawei adtype " + yourAdtype)
+	if openRTBImp.Banner == nil && openRTBImp.Native == nil && openRTBImp.Video == nil && openRTBImp.Audio == nil {
+		return errors.New("check openrtb format: please choose one of our supported type banner, native, video or audio")
+	}
+
+	var matched = false
+	var videoErr error
+	// video first, the banner size takes precedence when both are offered. An invalid video is treated as not
+	// offered, the imp fails only when no other format matches
+	if openRTBImp.Video != nil && (adtype == banner || adtype == interstitial || adtype == rewarded || adtype == roll) {
+		var videoSlot = *adslot30
+		if videoErr = getVideoFormat(&videoSlot, adtype, openRTBImp); videoErr == nil {
+			*adslot30 = videoSlot
+			matched = true
 		}
+	}
+	if openRTBImp.Banner != nil && (adtype == banner || adtype == interstitial) {
+		var videoFormat = format{adslot30.W, adslot30.H}
 		getBannerFormat(adslot30, openRTBImp)
-	} else if openRTBImp.Native != nil {
-		log.Println("c")
-		if adtype != native {
-			return errors.New("check openrtb format: request has native, doesn't correspond to huawei adtype " + yourAdtype)
+		if matched {
+			addFormat(adslot30, videoFormat)
 		}
+		matched = true
+	}
+	if openRTBImp.Native != nil && adtype == native {
 		if err := getNativeFormat(adslot30, openRTBImp); err != nil {
 			return err
 		}
-	} else if openRTBImp.Video != nil {
-		log.Println("d")
-		if adtype != banner && adtype != interstitial && adtype != rewarded && adtype != roll {
-			return errors.New("check openrtb format: request has video, doesn't correspond to huawei adtype " + yourAdtype)
-		}
-		if err := getVideoFormat(adslot30, adtype, openRTBImp); err != nil {
+		matched = true
+	}
+	if openRTBImp.Audio != nil && adtype == audio {
+		if err := getAudioFormat(adslot30, openRTBImp); err != nil {
 			return err
 		}
-	} else if openRTBImp.Audio != nil {
-		log.Println("e")
-		return errors.New("check openrtb format: request has audio, not currently supported")
-	} else {
-		return errors.New("check openrtb format: please choose one of our supported type banner, native, or video")
+		matched = true
+	}
+
+	if !matched && videoErr != nil {
+		return videoErr
+	}
+	if matched && videoErr != nil {
+		log.Println("video of imp " + openRTBImp.ID + " is not requested: " + videoErr.Error())
+	}
+	if !matched {
+		return errors.New("check openrtb format: request has " + strings.Join(getOfferedFormats(openRTBImp), ", ") +
+			", doesn't correspond to huawei adtype " + yourAdtype)
 	}
 	return nil
 }
 
-func getReqJson(request *HuaweiAdsRequest, openRTBRequest *openrtb2.BidRequest) (countryCode string, err error) {
+// addFormat: add the size to adslot30.Format if it is neither the slot size nor in the list
+func addFormat(adslot30 *adslot30, size format) {
+	if size.W == 0 || size.H == 0 || (size.W == adslot30.W && size.H == adslot30.H) {
+		return
+	}
+	for _, f := range adslot30.Format {
+		if f == size {
+			return
+		}
+	}
+	adslot30.Format = append(adslot30.Format, size)
+}
+
+func getOfferedFormats(openRTBImp *openrtb2.Imp) []string {
+	var formats []string
+	if openRTBImp.Banner != nil {
+		formats = append(formats, "banner")
+	}
+	if openRTBImp.Native != nil {
+		formats = append(formats, "native")
+	}
+	if openRTBImp.Video != nil {
+		formats = append(formats, "video")
+	}
+	if openRTBImp.Audio != nil {
+		formats = append(formats, "audio")
+	}
+	return formats
+}
+
+func (a *adapter) getReqJson(request *HuaweiAdsRequest, openRTBRequest *openrtb2.BidRequest, personalDataAllowed bool) (countryCode string, err error) {
 	request.Version = huaweiAdxApiVersion
-	if countryCode, err = getReqAppInfo(request, openRTBRequest); err != nil {
+	countryCode = a.getCountryCode(openRTBRequest)
+	if err = a.getReqAppInfo(request, openRTBRequest, countryCode); err != nil {
 		return "", err
 	}
-	if err = getReqDeviceInfo(request, openRTBRequest); err != nil {
+	if err = getReqDeviceInfo(request, openRTBRequest, countryCode, personalDataAllowed); err != nil {
 		return "", err
 	}
 	getReqNetWorkInfo(request, openRTBRequest)
 	getReqRegsInfo(request, openRTBRequest)
 	getReqGeoInfo(request, openRTBRequest)
 	getReqConsentInfo(request, openRTBRequest)
+	if !personalDataAllowed {
+		stripPersonalData(request)
+	}
 	return countryCode, nil
 }
 
-func getReqAppInfo(request *HuaweiAdsRequest, openRTBRequest *openrtb2.BidRequest) (countryCode string, err error) {
+func (a *adapter) getReqAppInfo(request *HuaweiAdsRequest, openRTBRequest *openrtb2.BidRequest, countryCode string) (err error) {
 	var app app
 	if openRTBRequest.App != nil {
 		if openRTBRequest.App.Ver != "" {
@@ -492,9 +736,9 @@ func getReqAppInfo(request *HuaweiAdsRequest, openRTBRequest *openrtb2.BidReques
 
 		// bundle cannot be empty, we need package name.
 		if openRTBRequest.App.Bundle != "" {
-			app.Pkgname = openRTBRequest.App.Bundle
+			app.Pkgname = getFinalPkgName(openRTBRequest.App.Bundle, a.extraInfo.PkgNameConvert)
 		} else {
-			return "", errors.New("generate HuaweiAds AppInfo failed: openrtb BidRequest.App.Bundle is empty.")
+			return errors.New("generate HuaweiAds AppInfo failed: openrtb BidRequest.App.Bundle is empty.")
 		}
 
 		if openRTBRequest.App.Content != nil && openRTBRequest.App.Content.Language != "" {
@@ -502,15 +746,82 @@ func getReqAppInfo(request *HuaweiAdsRequest, openRTBRequest *openrtb2.BidReques
 		} else {
 			app.Lang = "en"
 		}
+	} else if openRTBRequest.Site != nil {
+		if err = getReqSiteAppInfo(&app, openRTBRequest.Site); err != nil {
+			return err
+		}
+		app.Pkgname = getFinalPkgName(app.Pkgname, a.extraInfo.PkgNameConvert)
+	} else {
+		return errors.New("generate HuaweiAds AppInfo failed: openrtb BidRequest.App and BidRequest.Site are both empty.")
 	}
-	countryCode = getCountryCode(openRTBRequest)
 	app.Country = countryCode
 	request.App = app
-	return countryCode, nil
+	return nil
+}
+
+// getReqSiteAppInfo: mobile web inventory, the site domain is used as package name
+func getReqSiteAppInfo(app *app, site *openrtb2.Site) error {
+	var domain = site.Domain
+	if domain == "" && site.Page != "" {
+		if pageUrl, err := url.Parse(site.Page); err == nil {
+			domain = pageUrl.Hostname()
+		}
+	}
+	if domain == "" {
+		return errors.New("generate HuaweiAds AppInfo failed: openrtb BidRequest.Site.Domain and BidRequest.Site.Page are both empty.")
+	}
+	app.Pkgname = strings.ToLower(domain)
+
+	app.Name = site.Name
+	if app.Name == "" {
+		app.Name = app.Pkgname
+	}
+
+	if site.Content != nil && site.Content.Language != "" {
+		app.Lang = site.Content.Language
+	} else {
+		app.Lang = "en"
+	}
+	return nil
+}
+
+// getFinalPkgName: apply the pkgNameConvert rules in order. Exception names are never converted,
+// then exact names, prefixes and keywords are matched, "*" matches every package name
+func getFinalPkgName(bundleName string, pkgNameConverts []pkgNameConvert) string {
+	for _, convert := range pkgNameConverts {
+		if convert.ConvertedPkgName == "" {
+			continue
+		}
+
+		for _, name := range convert.ExceptionPkgNames {
+			if name == bundleName {
+				return bundleName
+			}
+		}
+
+		for _, name := range convert.UnconvertedPkgNames {
+			if name == bundleName || name == "*" {
+				return convert.ConvertedPkgName
+			}
+		}
+
+		for _, prefix := range convert.UnconvertedPkgNamePrefixs {
+			if prefix == "*" || (prefix != "" && strings.HasPrefix(bundleName, prefix)) {
+				return convert.ConvertedPkgName
+			}
+		}
+
+		for _, keyword := range convert.UnconvertedPkgNameKeyWords {
+			if keyword == "*" || (keyword != "" && strings.Contains(bundleName, keyword)) {
+				return convert.ConvertedPkgName
+			}
+		}
+	}
+	return bundleName
 }
 
 // getReqDeviceInfo: get device information for HuaweiAds request
-func getReqDeviceInfo(request *HuaweiAdsRequest, openRTBRequest *openrtb2.BidRequest) (err error) {
+func getReqDeviceInfo(request *HuaweiAdsRequest, openRTBRequest *openrtb2.BidRequest, country string, requireDeviceID bool) (err error) {
 	var device device
 	if openRTBRequest.Device != nil {
 		device.Type = int32(openRTBRequest.Device.DeviceType)
@@ -526,7 +837,6 @@ func getReqDeviceInfo(request *HuaweiAdsRequest, openRTBRequest *openrtb2.BidReq
 		device.Width = int32(openRTBRequest.Device.W)
 		device.Language = openRTBRequest.Device.Language
 		device.Pxratio = float32(openRTBRequest.Device.PxRatio)
-		var country = getCountryCode(openRTBRequest)
 		device.BelongCountry = country
 		device.LocaleCountry = country
 		device.Ip = openRTBRequest.Device.IP
@@ -534,7 +844,7 @@ func getReqDeviceInfo(request *HuaweiAdsRequest, openRTBRequest *openrtb2.BidReq
 	}
 
 	// get oaid gaid imei in openRTBRequest.User.Ext.Data
-	if err = getDeviceIDFromUserExt(&device, openRTBRequest); err != nil {
+	if err = getDeviceIDFromUserExt(&device, openRTBRequest, requireDeviceID); err != nil {
 		return err
 	}
 
@@ -552,58 +862,48 @@ func getReqDeviceInfo(request *HuaweiAdsRequest, openRTBRequest *openrtb2.BidReq
 	return nil
 }
 
-func getCountryCode(openRTBRequest *openrtb2.BidRequest) string {
+// getCountryCode: device geo first, then user geo, then MCC, then device IP in the GeoIP database. A country
+// which is not in ISO 3166-1 is reported and the next source is used, the country decides endpoint routing and
+// targeting
+func (a *adapter) getCountryCode(openRTBRequest *openrtb2.BidRequest) string {
 	if openRTBRequest.Device != nil && openRTBRequest.Device.Geo != nil && openRTBRequest.Device.Geo.Country != "" {
-		return convertCountryCode(openRTBRequest.Device.Geo.Country)
-	} else if openRTBRequest.User != nil && openRTBRequest.User.Geo != nil && openRTBRequest.User.Geo.Country != "" {
-		return convertCountryCode(openRTBRequest.User.Geo.Country)
-	} else if openRTBRequest.Device != nil && openRTBRequest.Device.MCCMNC != "" {
-		return getCountryCodeFromMCC(openRTBRequest.Device.MCCMNC)
-	} else {
-		return defaultCountryName
+		if countryCode, valid := convertCountryCode(openRTBRequest.Device.Geo.Country); valid {
+			return countryCode
+		}
 	}
-}
-
-// convertCountryCode: ISO 3166-1 Alpha3 -> Alpha2, Some countries may use
-func convertCountryCode(country string) (out string) {
-	if country == "" {
-		return defaultCountryName
+	if openRTBRequest.User != nil && openRTBRequest.User.Geo != nil && openRTBRequest.User.Geo.Country != "" {
+		if countryCode, valid := convertCountryCode(openRTBRequest.User.Geo.Country); valid {
+			return countryCode
+		}
 	}
-	var mapCountryCodeAlpha3ToAlpha2 = map[string]string{"AND": "AD", "AGO": "AO", "AUT": "AT", "BGD": "BD",
-		"BLR": "BY", "CAF": "CF", "TCD": "TD", "CHL": "CL", "CHN": "CN", "COG": "CG", "COD": "CD", "DNK": "DK",
-		"GNQ": "GQ", "EST": "EE", "GIN": "GN", "GNB": "GW", "GUY": "GY", "IRQ": "IQ", "IRL": "IE", "ISR": "IL",
-		"KAZ": "KZ", "LBY": "LY", "MDG": "MG", "MDV": "MV", "MEX": "MX", "MNE": "ME", "MOZ": "MZ", "PAK": "PK",
-		"PNG": "PG", "PRY": "PY", "POL": "PL", "PRT": "PT", "SRB": "RS", "SVK": "SK", "SVN": "SI", "SWE": "SE",
-		"TUN": "TN", "TUR": "TR", "TKM": "TM", "UKR": "UA", "ARE": "AE", "URY": "UY"}
-	if mappedCountry, exists := mapCountryCodeAlpha3ToAlpha2[country]; exists {
-		return mappedCountry
+	if openRTBRequest.Device != nil && openRTBRequest.Device.MCCMNC != "" {
+		if countryCode, found := getCountryCodeFromMCC(openRTBRequest.Device.MCCMNC); found {
+			return countryCode
+		}
 	}
-
-	if len(country) >= 3 {
-		return country[0:2]
+	if countryCode, found := a.getCountryCodeFromIP(openRTBRequest.Device); found {
+		return countryCode
 	}
-
-	return defaultCountryName
+	return a.defaultCountry
 }
 
-func getCountryCodeFromMCC(MCC string) (out string) {
-	var countryMCC = strings.Split(MCC, "-")[0]
-	intVar, err := strconv.Atoi(countryMCC)
-
-	if err != nil {
-		return defaultCountryName
-	} else {
-		if result, found := constants.MccList[intVar]; found {
-			return strings.ToUpper(result)
-		} else {
-			return defaultCountryName
-		}
+// convertCountryCode: ISO 3166-1 alpha-3 or alpha-2 in any case -> alpha-2, unknown codes are reported, not guessed
+func convertCountryCode(country string) (string, bool) {
+	countryCode, valid := constants.ToCountryAlpha2(country)
+	if !valid {
+		log.Println("convert country code failed: " + country + " is not an ISO 3166-1 alpha-2 or alpha-3 code")
 	}
+	return countryCode, valid
+}
+
+// getCountryCodeFromMCC: openrtb device.mccmnc is "mcc-mnc", the country only depends on the mcc
+func getCountryCodeFromMCC(MCC string) (string, bool) {
+	return constants.GetCountryByMcc(strings.Split(MCC, "-")[0])
 }
 
-// getDeviceID include oaid gaid imei. In prebid mobile, use TargetingParams.addUserData("imei", "imei-test");
+// getDeviceID include oaid gaid imei. A device id is not required when personal data can't be sent. In prebid mobile, use TargetingParams.addUserData("imei", "imei-test");
 // When ifa: gaid exists, other device id can be passed by TargetingParams.addUserData("oaid", "oaid-test");
-func getDeviceIDFromUserExt(device *device, openRTBRequest *openrtb2.BidRequest) (err error) {
+func getDeviceIDFromUserExt(device *device, openRTBRequest *openrtb2.BidRequest, requireDeviceID bool) (err error) {
 	var userObjExist = true
 	if openRTBRequest.User == nil || openRTBRequest.User.Ext == nil {
 		userObjExist = false
@@ -630,14 +930,15 @@ func getDeviceIDFromUserExt(device *device, openRTBRequest *openrtb2.BidRequest)
 			isValidDeviceId = true
 		}
 
-		if !isValidDeviceId {
+		if !isValidDeviceId && openRTBRequest.App != nil && requireDeviceID {
 			return errors.New("getDeviceID: Imei ,Oaid, Gaid are all empty.")
 		}
 		if len(deviceId.ClientTime) > 0 {
 			device.ClientTime = getClientTime(deviceId.ClientTime[0])
 		}
 	} else {
-		if len(device.Gaid) == 0 {
+		// mobile web traffic usually has no device id
+		if len(device.Gaid) == 0 && openRTBRequest.App != nil && requireDeviceID {
 			return errors.New("getDeviceID: openRTBRequest.User.Ext is nil and device.Gaid is not specified.")
 		}
 	}
@@ -675,23 +976,13 @@ func getReqNetWorkInfo(request *HuaweiAdsRequest, openRTBRequest *openrtb2.BidRe
 
 		var cellInfos []cellInfo
 		if openRTBRequest.Device.MCCMNC != "" {
-			var arr = strings.Split(openRTBRequest.Device.MCCMNC, "-")
 			network.Carrier = 0
-			if len(arr) >= 2 {
+			if mcc, mnc, ok := constants.ParseMccMnc(openRTBRequest.Device.MCCMNC); ok {
 				cellInfos = append(cellInfos, cellInfo{
-					Mcc: arr[0],
-					Mnc: arr[1],
+					Mcc: mcc,
+					Mnc: mnc,
 				})
-				var str = arr[0] + arr[1]
-				if str == "46000" || str == "46002" || str == "46007" {
-					network.Carrier = 2
-				} else if str == "46001" || str == "46006" {
-					network.Carrier = 1
-				} else if str == "46003" || str == "46005" || str == "46011" {
-					network.Carrier = 3
-				} else {
-					network.Carrier = 99
-				}
+				network.Carrier = constants.GetHuaweiCarrier(mcc, mnc)
 			}
 		}
 		network.CellInfo = cellInfos
@@ -699,7 +990,8 @@ func getReqNetWorkInfo(request *HuaweiAdsRequest, openRTBRequest *openrtb2.BidRe
 	}
 }
 
-// getReqRegsInfo: get regs information for HuaweiAds request, include Coppa
+// getReqRegsInfo: get regs information for HuaweiAds request, include Coppa. regs of the HuaweiAds 3.4 API
+// (huaweiAdxApiVersion) only defines coppa, us_privacy and gpp are applied before sending, see getUsPrivacyOptOut
 func getReqRegsInfo(request *HuaweiAdsRequest, openRTBRequest *openrtb2.BidRequest) {
 	if openRTBRequest.Regs != nil && openRTBRequest.Regs.COPPA >= 0 {
 		var regs regs
@@ -720,16 +1012,9 @@ func getReqGeoInfo(request *HuaweiAdsRequest, openRTBRequest *openrtb2.BidReques
 	}
 }
 
-// getReqGeoInfo: get GDPR consent
+// getReqConsentInfo: get GDPR consent
 func getReqConsentInfo(request *HuaweiAdsRequest, openRTBRequest *openrtb2.BidRequest) {
-	if openRTBRequest.User != nil && openRTBRequest.User.Ext != nil {
-		var extUser ExtUser
-		if err := json.Unmarshal(openRTBRequest.User.Ext, &extUser); err != nil {
-			// fmt.Errorf("failed to parse ExtUser in HuaweiAds GDPR check: %v", err)
-			return
-		}
-		request.Consent = extUser.Consent
-	}
+	request.Consent = getConsentString(openRTBRequest)
 }
 
 func getBannerFormat(adslot30 *adslot30, openRTBImp *openrtb2.Imp) {
@@ -748,76 +1033,215 @@ func getBannerFormat(adslot30 *adslot30, openRTBImp *openrtb2.Imp) {
 	}
 }
 
-func getNativeFormat(adslot30 *adslot30, openRTBImp *openrtb2.Imp) error {
-	if openRTBImp.Native.Request == "" {
-		return errors.New("extract openrtb native failed: imp.Native.Request is empty")
+// nativeAssetModel: the assets of imp.Native.Request, the request side maps them into adslot30 and the
+// response side fills the same asset ids
+type nativeAssetModel struct {
+	assets       []nativeRequests.Asset
+	numMainImage int
+	numVideo     int
+	// a required main image or video limits the detailedCreativeTypeList
+	mainImageRequired bool
+	videoRequired     bool
+	width             int64
+	height            int64
+}
+
+// supportedNativeDataTypes: data assets huaweiads content can fill
+var supportedNativeDataTypes = map[native1.DataAssetType]empty{
+	native1.DataAssetTypeSponsored: {},
+	native1.DataAssetTypeDesc:      {},
+	native1.DataAssetTypeDesc2:     {},
+	native1.DataAssetTypeCTAText:   {},
+}
+
+func getNativeAssetModel(openRTBImp *openrtb2.Imp) (*nativeAssetModel, error) {
+	if openRTBImp.Native == nil || openRTBImp.Native.Request == "" {
+		return nil, errors.New("extract openrtb native failed: imp.Native.Request is empty")
 	}
 
 	var nativePayload nativeRequests.Request
 	if err := json.Unmarshal(json.RawMessage(openRTBImp.Native.Request), &nativePayload); err != nil {
-		return err
+		return nil, err
 	}
 
-	// only compute the main image number, type = native1.ImageAssetTypeMain
-	var numMainImage = 0
-	var numVideo = 0
-	var width int64
-	var height int64
+	var model = nativeAssetModel{assets: nativePayload.Assets}
+	var videoWidth, videoHeight int64
 	for _, asset := range nativePayload.Assets {
+		var required = asset.Required == 1
 		// Only one of the {title,img,video,data} objects should be present in each object.
 		if asset.Video != nil {
-			numVideo++
-			continue
-		}
-		// every image has the same W, H.
-		if asset.Img != nil {
-			if asset.Img.Type == native1.ImageAssetTypeMain {
-				numMainImage++
+			model.numVideo++
+			model.videoRequired = model.videoRequired || required
+			if videoWidth == 0 && asset.Video.W != 0 && asset.Video.H != 0 {
+				videoWidth, videoHeight = asset.Video.W, asset.Video.H
+			}
+		} else if asset.Img != nil {
+			if asset.Img.Type != native1.ImageAssetTypeMain {
+				continue
+			}
+			model.numMainImage++
+			model.mainImageRequired = model.mainImageRequired || required
+			// the first main image with size decides the slot size, exact size first
+			if model.width == 0 {
 				if asset.Img.H != 0 && asset.Img.W != 0 {
-					width = asset.Img.W
-					height = asset.Img.H
+					model.width, model.height = asset.Img.W, asset.Img.H
 				} else if asset.Img.WMin != 0 && asset.Img.HMin != 0 {
-					width = asset.Img.WMin
-					height = asset.Img.HMin
+					model.width, model.height = asset.Img.WMin, asset.Img.HMin
 				}
 			}
-			continue
+		} else if asset.Data != nil {
+			if _, supported := supportedNativeDataTypes[asset.Data.Type]; !supported && required {
+				return nil, errors.New("extract openrtb native failed: required data asset type " +
+					strconv.Itoa(int(asset.Data.Type)) + " is not supported, asset id: " + strconv.FormatInt(asset.ID, 10))
+			}
+		} else if asset.Title == nil && required {
+			return nil, errors.New("extract openrtb native failed: required asset has no title, img, video or data, asset id: " +
+				strconv.FormatInt(asset.ID, 10))
 		}
 	}
-	adslot30.W = width
-	adslot30.H = height
+	if model.videoRequired && model.mainImageRequired {
+		return nil, errors.New("extract openrtb native failed: main image and video are both required.")
+	}
+	if model.width == 0 {
+		model.width, model.height = videoWidth, videoHeight
+	}
+	return &model, nil
+}
+
+// getNativeFormat: slot size and detailedCreativeTypeList from the native assets. The title len is not sent on
+// purpose, adslot30 has no title length field, getNativeAdm truncates the title to len instead
+func getNativeFormat(adslot30 *adslot30, openRTBImp *openrtb2.Imp) error {
+	model, err := getNativeAssetModel(openRTBImp)
+	if err != nil {
+		return err
+	}
+	adslot30.W = model.width
+	adslot30.H = model.height
 
 	var detailedCreativeTypeList = make([]string, 0, 2)
-	if numVideo >= 1 {
+	if model.numVideo >= 1 && !model.mainImageRequired {
 		detailedCreativeTypeList = append(detailedCreativeTypeList, "903")
-	} else if numMainImage > 1 {
-		detailedCreativeTypeList = append(detailedCreativeTypeList, "904")
-	} else if numMainImage == 1 {
-		detailedCreativeTypeList = append(detailedCreativeTypeList, "901")
-	} else {
+	}
+	if !model.videoRequired {
+		if model.numMainImage > 1 {
+			detailedCreativeTypeList = append(detailedCreativeTypeList, "904")
+		} else if model.numMainImage == 1 {
+			detailedCreativeTypeList = append(detailedCreativeTypeList, "901")
+		}
+	}
+	if len(detailedCreativeTypeList) == 0 {
 		detailedCreativeTypeList = append(detailedCreativeTypeList, "913", "914")
 	}
 	adslot30.DetailedCreativeTypeList = detailedCreativeTypeList
 	return nil
 }
 
-// roll ad need TotalDuration
+// getVideoFormat: roll ad need TotalDuration. adslot30 of the HuaweiAds 3.4 API has no mimes, skip or start delay
+// field, so the rest of the video object is only checked: requests huaweiads can't satisfy, e.g. non-linear,
+// VPAID-only, audio-only mimes or VAST 1/2 only, are rejected
 func getVideoFormat(adslot30 *adslot30, adtype int32, openRTBImp *openrtb2.Imp) error {
-	adslot30.W = openRTBImp.Video.W
-	adslot30.H = openRTBImp.Video.H
+	var video = openRTBImp.Video
+	if err := checkVideoMimes(video.MIMEs); err != nil {
+		return err
+	}
+	if err := checkVideoProtocols(video); err != nil {
+		return err
+	}
+	if video.Linearity == adcom1.LinearityNonLinear {
+		return errors.New("extract openrtb video failed: non-linear video is not supported.")
+	}
+	if adtype == roll && video.Placement != 0 && video.Placement != adcom1.VideoInStream {
+		return errors.New("extract openrtb video failed: huaweiads adtype roll only supports in-stream placement.")
+	}
+	if adtype != roll && video.Placement == adcom1.VideoInStream {
+		return errors.New("extract openrtb video failed: in-stream placement needs huaweiads adtype roll.")
+	}
+	if video.MaxDuration != 0 && video.MinDuration > video.MaxDuration {
+		return errors.New("extract openrtb video failed: MinDuration is greater than MaxDuration.")
+	}
+
+	adslot30.W = video.W
+	adslot30.H = video.H
+	if video.W != 0 && video.H != 0 {
+		adslot30.Orientation = landscape
+		if video.H > video.W {
+			adslot30.Orientation = portrait
+		}
+	}
 
 	if adtype == roll {
-		if openRTBImp.Video.MaxDuration == 0 {
+		if video.MaxDuration == 0 {
 			return errors.New("extract openrtb video failed: MaxDuration is empty when huaweiads adtype is roll.")
 		}
-		adslot30.TotalDuration = int32(openRTBImp.Video.MaxDuration)
+		adslot30.TotalDuration = int32(video.MaxDuration)
+	}
+
+	// video only, unless banner creatives are also accepted
+	if openRTBImp.Banner == nil || (adtype != banner && adtype != interstitial) {
+		adslot30.DetailedCreativeTypeList = []string{"903"}
 	}
 	return nil
 }
 
-func getFinalEndPoint(countryCode string) string {
+// checkVideoMimes: huaweiads only returns video files, an empty list means any mime
+func checkVideoMimes(mimes []string) error {
+	if len(mimes) == 0 {
+		return nil
+	}
+	var vpaidOnly = true
+	for _, mime := range mimes {
+		var lowerMime = strings.ToLower(mime)
+		if strings.HasPrefix(lowerMime, "video/") {
+			return nil
+		}
+		if lowerMime != "application/javascript" && lowerMime != "application/x-shockwave-flash" {
+			vpaidOnly = false
+		}
+	}
+	if vpaidOnly {
+		return errors.New("extract openrtb video failed: VPAID-only mimes are not supported.")
+	}
+	return errors.New("extract openrtb video failed: no video mime in " + strings.Join(mimes, ", ") + ".")
+}
+
+// checkVideoProtocols: the video adm is an InLine VAST 3 or VAST 4
+func checkVideoProtocols(video *openrtb2.Video) error {
+	var protocols = video.Protocols
+	if video.Protocol != 0 {
+		protocols = append([]adcom1.MediaCreativeSubtype{video.Protocol}, protocols...)
+	}
+	if len(protocols) == 0 {
+		return nil
+	}
+	for _, protocol := range protocols {
+		switch protocol {
+		case adcom1.CreativeVAST30, adcom1.CreativeVAST30Wrapper, adcom1.CreativeVAST40, adcom1.CreativeVAST40Wrapper,
+			adcom1.CreativeVAST41, adcom1.CreativeVAST41Wrapper, adcom1.CreativeVAST42, adcom1.CreativeVAST42Wrapper:
+			return nil
+		}
+	}
+	return errors.New("extract openrtb video failed: protocols don't include VAST 3.0 or VAST 4.x.")
+}
+
+// audio ad need TotalDuration
+func getAudioFormat(adslot30 *adslot30, openRTBImp *openrtb2.Imp) error {
+	if openRTBImp.Audio.MaxDuration == 0 {
+		return errors.New("extract openrtb audio failed: MaxDuration is empty when huaweiads adtype is audio.")
+	}
+	if openRTBImp.Audio.MinDuration > openRTBImp.Audio.MaxDuration {
+		return errors.New("extract openrtb audio failed: MinDuration is greater than MaxDuration.")
+	}
+	adslot30.TotalDuration = int32(openRTBImp.Audio.MaxDuration)
+	return nil
+}
+
+// getFinalEndPoint: choose site by country, unless extraInfo.CloseSiteSelectionByCountry is "1"
+func (a *adapter) getFinalEndPoint(countryCode string) string {
+	if a.extraInfo.CloseSiteSelectionByCountry == closeSiteSelectionByCountry {
+		return a.endpoint
+	}
 	if countryCode == "" || len(countryCode) > 2 {
-		return defaultEndpoint
+		return a.endpoint
 	}
 	var europeanSiteCountryCodeGroup = map[string]empty{"AX": {}, "AL": {}, "AD": {}, "AU": {}, "AT": {}, "BE": {},
 		"BA": {}, "BG": {}, "CA": {}, "HR": {}, "CY": {}, "CZ": {}, "DK": {}, "EE": {}, "FO": {}, "FI": {},
@@ -830,13 +1254,13 @@ func getFinalEndPoint(countryCode string) string {
 	var chineseSiteCountryCodeGroup = map[string]empty{"CN": {}}
 	// choose site
 	if _, exists := chineseSiteCountryCodeGroup[countryCode]; exists {
-		return chineseSiteEndPoint
+		return a.chineseSiteEndpoint
 	} else if _, exists := russianSiteCountryCodeGroup[countryCode]; exists {
-		return russianSiteEndPoint
+		return a.russianSiteEndpoint
 	} else if _, exists := europeanSiteCountryCodeGroup[countryCode]; exists {
-		return europeanSiteEndPoint
+		return a.europeanSiteEndpoint
 	} else {
-		return asianSiteEndPoint
+		return a.asianSiteEndpoint
 	}
 }
 
@@ -0,0 +1,219 @@
+package adapters
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/prebid/openrtb/v17/adcom1"
+	openrtb2 "github.com/prebid/openrtb/v17/openrtb2"
+)
+
+func TestGetVideoFormat(t *testing.T) {
+	tests := []struct {
+		name          string
+		adtype        int32
+		video         openrtb2.Video
+		totalDuration int32
+		orientation   int32
+		creativeTypes []string
+	}{
+		{
+			name:          "rewarded portrait",
+			adtype:        rewarded,
+			video:         openrtb2.Video{MIMEs: []string{"video/mp4"}, W: 720, H: 1280, MaxDuration: 30},
+			orientation:   portrait,
+			creativeTypes: []string{"903"},
+		},
+		{
+			name:   "roll",
+			adtype: roll,
+			video: openrtb2.Video{MIMEs: []string{"application/javascript", "video/mp4"}, W: 1280, H: 720, MinDuration: 5,
+				MaxDuration: 60, Protocols: []adcom1.MediaCreativeSubtype{adcom1.CreativeVAST20, adcom1.CreativeVAST30},
+				Placement: adcom1.VideoInStream, Linearity: adcom1.LinearityLinear},
+			totalDuration: 60,
+			orientation:   landscape,
+			creativeTypes: []string{"903"},
+		},
+		{
+			name:          "no mimes and no protocols",
+			adtype:        rewarded,
+			video:         openrtb2.Video{},
+			creativeTypes: []string{"903"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var slot adslot30
+			err := getVideoFormat(&slot, test.adtype, &openrtb2.Imp{Video: &test.video})
+			if err != nil {
+				t.Fatalf("getVideoFormat: %v", err)
+			}
+			if slot.W != test.video.W || slot.H != test.video.H || slot.Orientation != test.orientation {
+				t.Errorf("size = %dx%d, orientation %d, want %dx%d, orientation %d", slot.W, slot.H, slot.Orientation,
+					test.video.W, test.video.H, test.orientation)
+			}
+			if slot.TotalDuration != test.totalDuration {
+				t.Errorf("TotalDuration = %d, want %d", slot.TotalDuration, test.totalDuration)
+			}
+			if !reflect.DeepEqual(slot.DetailedCreativeTypeList, test.creativeTypes) {
+				t.Errorf("DetailedCreativeTypeList = %v, want %v", slot.DetailedCreativeTypeList, test.creativeTypes)
+			}
+		})
+	}
+}
+
+func TestGetVideoFormatErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		adtype int32
+		video  openrtb2.Video
+		err    string
+	}{
+		{name: "VPAID-only mimes", adtype: rewarded,
+			video: openrtb2.Video{MIMEs: []string{"application/javascript", "application/x-shockwave-flash"}}, err: "VPAID-only"},
+		{name: "audio-only mimes", adtype: rewarded, video: openrtb2.Video{MIMEs: []string{"audio/mp4", "audio/mpeg"}},
+			err: "no video mime in audio/mp4, audio/mpeg"},
+		{name: "VAST 1/2-only protocols", adtype: rewarded,
+			video: openrtb2.Video{Protocols: []adcom1.MediaCreativeSubtype{adcom1.CreativeVAST10, adcom1.CreativeVAST20,
+				adcom1.CreativeVAST20Wrapper}}, err: "don't include VAST 3.0 or VAST 4.x"},
+		{name: "VAST 2 deprecated protocol", adtype: rewarded, video: openrtb2.Video{Protocol: adcom1.CreativeVAST20},
+			err: "don't include VAST 3.0 or VAST 4.x"},
+		{name: "non-linear video", adtype: rewarded, video: openrtb2.Video{Linearity: adcom1.LinearityNonLinear},
+			err: "non-linear"},
+		{name: "roll out-stream placement", adtype: roll, video: openrtb2.Video{MaxDuration: 30, Placement: adcom1.VideoInBanner},
+			err: "roll only supports in-stream"},
+		{name: "in-stream placement without roll", adtype: rewarded, video: openrtb2.Video{Placement: adcom1.VideoInStream},
+			err: "in-stream placement needs huaweiads adtype roll"},
+		{name: "min duration above max duration", adtype: rewarded, video: openrtb2.Video{MinDuration: 31, MaxDuration: 30},
+			err: "MinDuration is greater than MaxDuration"},
+		{name: "roll without max duration", adtype: roll, video: openrtb2.Video{}, err: "MaxDuration is empty"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var slot adslot30
+			err := getVideoFormat(&slot, test.adtype, &openrtb2.Imp{Video: &test.video})
+			if err == nil {
+				t.Fatalf("getVideoFormat returned %+v, want an error", slot)
+			}
+			if !strings.Contains(err.Error(), test.err) {
+				t.Errorf("error = %q, want it to contain %q", err, test.err)
+			}
+		})
+	}
+}
+
+func TestGetReqRegsInfo(t *testing.T) {
+	var request HuaweiAdsRequest
+	getReqRegsInfo(&request, &openrtb2.BidRequest{Regs: &openrtb2.Regs{COPPA: 1, USPrivacy: "1YYN", GPP: "DBABzw~1YYN",
+		GPPSID: []int8{6}}})
+	regs, err := json.Marshal(request.Regs)
+	if err != nil {
+		t.Fatalf("marshal regs: %v", err)
+	}
+	if string(regs) != `{"coppa":1}` {
+		t.Errorf("regs = %s, want only the coppa field of the HuaweiAds API", regs)
+	}
+}
+
+func TestGetNativeFormat(t *testing.T) {
+	tests := []struct {
+		name          string
+		request       string
+		w             int64
+		h             int64
+		creativeTypes []string
+	}{
+		{
+			name:          "one main image",
+			request:       `{"assets":[{"id":1,"title":{"len":20}},{"id":2,"required":1,"img":{"type":3,"w":720,"h":1280}}]}`,
+			w:             720,
+			h:             1280,
+			creativeTypes: []string{"901"},
+		},
+		{
+			name: "several main images",
+			request: `{"assets":[{"id":1,"img":{"type":3,"wmin":300,"hmin":250}},{"id":2,"img":{"type":3,"w":1080,"h":607}},
+				{"id":3,"img":{"type":1,"w":50,"h":50}}]}`,
+			w:             300,
+			h:             250,
+			creativeTypes: []string{"904"},
+		},
+		{
+			name:          "optional main image and video",
+			request:       `{"assets":[{"id":1,"img":{"type":3}},{"id":2,"video":{"mimes":["video/mp4"],"w":1280,"h":720}}]}`,
+			w:             1280,
+			h:             720,
+			creativeTypes: []string{"903", "901"},
+		},
+		{
+			name:          "required video",
+			request:       `{"assets":[{"id":1,"img":{"type":3,"w":720,"h":1280}},{"id":2,"required":1,"video":{"mimes":["video/mp4"]}}]}`,
+			w:             720,
+			h:             1280,
+			creativeTypes: []string{"903"},
+		},
+		{
+			name:          "required main image",
+			request:       `{"assets":[{"id":1,"required":1,"img":{"type":3}},{"id":2,"img":{"type":3}},{"id":3,"video":{}}]}`,
+			creativeTypes: []string{"904"},
+		},
+		{
+			name:          "no main image and no video",
+			request:       `{"assets":[{"id":1,"required":1,"title":{"len":25}},{"id":2,"required":1,"data":{"type":2}}]}`,
+			creativeTypes: []string{"913", "914"},
+		},
+		{
+			name:          "optional unsupported data asset",
+			request:       `{"assets":[{"id":1,"data":{"type":3}},{"id":2,"img":{"type":3,"w":320,"h":480}}]}`,
+			w:             320,
+			h:             480,
+			creativeTypes: []string{"901"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var slot adslot30
+			err := getNativeFormat(&slot, &openrtb2.Imp{Native: &openrtb2.Native{Request: test.request}})
+			if err != nil {
+				t.Fatalf("getNativeFormat: %v", err)
+			}
+			if slot.W != test.w || slot.H != test.h {
+				t.Errorf("size = %dx%d, want %dx%d", slot.W, slot.H, test.w, test.h)
+			}
+			if !reflect.DeepEqual(slot.DetailedCreativeTypeList, test.creativeTypes) {
+				t.Errorf("DetailedCreativeTypeList = %v, want %v", slot.DetailedCreativeTypeList, test.creativeTypes)
+			}
+		})
+	}
+}
+
+func TestGetNativeAssetModelErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		native *openrtb2.Native
+		err    string
+	}{
+		{name: "no native request", native: &openrtb2.Native{}, err: "imp.Native.Request is empty"},
+		{name: "malformed native request", native: &openrtb2.Native{Request: `{"assets":`}, err: "unexpected end of JSON input"},
+		{name: "required rating", native: &openrtb2.Native{Request: `{"assets":[{"id":4,"required":1,"data":{"type":3}}]}`},
+			err: "required data asset type 3 is not supported, asset id: 4"},
+		{name: "required asset without object", native: &openrtb2.Native{Request: `{"assets":[{"id":5,"required":1}]}`},
+			err: "required asset has no title, img, video or data, asset id: 5"},
+		{name: "required main image and video", native: &openrtb2.Native{
+			Request: `{"assets":[{"id":1,"required":1,"img":{"type":3}},{"id":2,"required":1,"video":{"mimes":["video/mp4"]}}]}`},
+			err: "main image and video are both required"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			model, err := getNativeAssetModel(&openrtb2.Imp{Native: test.native})
+			if err == nil {
+				t.Fatalf("getNativeAssetModel returned %+v, want an error", model)
+			}
+			if !strings.Contains(err.Error(), test.err) {
+				t.Errorf("error = %q, want it to contain %q", err, test.err)
+			}
+		})
+	}
+}
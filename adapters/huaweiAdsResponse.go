@@ -0,0 +1,373 @@
+package adapters
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"strconv"
+
+	"github.com/prebid/openrtb/v17/native1"
+	nativeResponse "github.com/prebid/openrtb/v17/native1/response"
+	openrtb2 "github.com/prebid/openrtb/v17/openrtb2"
+)
+
+const huaweiAdsSeat = "huaweiads"
+const nativeResponseVersion = "1.2"
+
+// monitor event type
+const (
+	impEventType       = "imp"
+	clickEventType     = "click"
+	vastErrorEventType = "vastError"
+)
+
+// MakeBids: translate the raw HuaweiAds response body into an openrtb2.BidResponse. When no bid is returned,
+// BidResponse.NBR tells why; retcodes other than success and no fill come back as *RetcodeError
+func (a *adapter) MakeBids(openRTBRequest *openrtb2.BidRequest, responseBody []byte) (*openrtb2.BidResponse, []error) {
+	var huaweiAdsResponse huaweiAdsResponse
+	if err := json.Unmarshal(responseBody, &huaweiAdsResponse); err != nil {
+		return &openrtb2.BidResponse{ID: openRTBRequest.ID, NBR: NoBidMalformedResponse.Ptr()},
+			[]error{errors.New("Unable to unmarshal huaweiAdsResponse: " + err.Error())}
+	}
+
+	if retcodeErr := checkHuaweiAdsResponseRetcode(huaweiAdsResponse); retcodeErr != nil {
+		var bidResponse = openrtb2.BidResponse{ID: openRTBRequest.ID, NBR: retcodeErr.NBR.Ptr()}
+		if retcodeErr.Category == RetcodeNoFill {
+			return &bidResponse, nil
+		}
+		return &bidResponse, []error{retcodeErr}
+	}
+
+	return a.convertHuaweiAdsRespToBidResponse(openRTBRequest, &huaweiAdsResponse)
+}
+
+// checkHuaweiAdsResponseRetcode: nil when retcode is a success, see huaweiAdsRetcodes
+func checkHuaweiAdsResponseRetcode(response huaweiAdsResponse) *RetcodeError {
+	retcodeErr := newRetcodeError(huaweiAdsRetcodes, response.Retcode, "", response.Reason)
+	if retcodeErr.Category == RetcodeSuccess {
+		return nil
+	}
+	return retcodeErr
+}
+
+// convertHuaweiAdsRespToBidResponse: one openrtb2.Bid per content, matched back to the imp by ad30.Slotid
+func (a *adapter) convertHuaweiAdsRespToBidResponse(openRTBRequest *openrtb2.BidRequest, huaweiAdsResponse *huaweiAdsResponse) (*openrtb2.BidResponse, []error) {
+	var bidResponse = openrtb2.BidResponse{
+		ID:  openRTBRequest.ID,
+		Cur: getRequestCurrency(openRTBRequest),
+	}
+	// no fill, return an empty bid response
+	if len(huaweiAdsResponse.Multiad) == 0 {
+		bidResponse.NBR = NoBidNoFill.Ptr()
+		return &bidResponse, nil
+	}
+
+	// slotid -> imps, several imps can share a slot, each ad30 is paired with the next imp not used yet
+	var errs []error
+	var slotIdToImps = make(map[string][]openrtb2.Imp, len(openRTBRequest.Imp))
+	for _, imp := range openRTBRequest.Imp {
+		publishersCredential, err := GetPublishersCredentials(&imp)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		slotIdToImps[publishersCredential.SlotId] = append(slotIdToImps[publishersCredential.SlotId], imp)
+	}
+
+	var bids []openrtb2.Bid
+	var nbr = NoBidNoFill
+	for _, ad30 := range huaweiAdsResponse.Multiad {
+		imps, exists := slotIdToImps[ad30.Slotid]
+		if !exists {
+			errs = append(errs, errors.New("HuaweiAdsResponse slotid: "+ad30.Slotid+" matches no requested imp"))
+			continue
+		}
+		if len(imps) == 0 {
+			errs = append(errs, errors.New("HuaweiAdsResponse slotid: "+ad30.Slotid+" has more ad30 than requested imps"))
+			continue
+		}
+		imp := imps[0]
+		slotIdToImps[ad30.Slotid] = imps[1:]
+		if retcodeErr := newRetcodeError(huaweiAdsSlotRetcodes, ad30.Retcode30, ad30.Slotid, ""); retcodeErr.Category != RetcodeSuccess {
+			if retcodeErr.Category != RetcodeNoFill {
+				nbr = retcodeErr.NBR
+				errs = append(errs, retcodeErr)
+			}
+			continue
+		}
+
+		for _, content := range ad30.Content {
+			// a price which can't be normalized to the request currency would be mispriced, reject the content
+			price, err := a.convertCurrency(content.Price, getContentCurrency(content.Cur), bidResponse.Cur)
+			if err != nil {
+				errs = append(errs, errors.New("content "+content.Contentid+" of slotid "+ad30.Slotid+" is rejected: "+err.Error()))
+				nbr = NoBidCurrencyNotConvertible
+				continue
+			}
+			// the same for a floor, the content can't be proven to clear it
+			belowBidFloor, err := a.isBelowBidFloor(price, bidResponse.Cur, &content, &imp)
+			if err != nil {
+				err = errors.New("content " + content.Contentid + " of slotid " + ad30.Slotid + " is rejected: bid floor of imp " +
+					imp.ID + " can't be converted: " + err.Error())
+				log.Println(err)
+				errs = append(errs, err)
+				nbr = NoBidCurrencyNotConvertible
+				continue
+			}
+			if belowBidFloor {
+				continue
+			}
+			bid, err := getBidFromContent(ad30.AdType, &content, &imp)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			bid.Price = price
+			bids = append(bids, bid)
+		}
+	}
+
+	if len(bids) > 0 {
+		bidResponse.SeatBid = []openrtb2.SeatBid{{
+			Seat: huaweiAdsSeat,
+			Bid:  bids,
+		}}
+	} else {
+		bidResponse.NBR = nbr.Ptr()
+	}
+	return &bidResponse, errs
+}
+
+// isBelowBidFloor: price is in the request currency, an error when the floor can't be converted to it
+func (a *adapter) isBelowBidFloor(price float64, cur string, content *content, imp *openrtb2.Imp) (bool, error) {
+	if imp.BidFloor <= 0 {
+		return false, nil
+	}
+	bidFloor, err := a.convertCurrency(imp.BidFloor, getFloorCurrency(imp.BidFloorCur), cur)
+	if err != nil {
+		return false, err
+	}
+	if price < bidFloor {
+		log.Println("content " + content.Contentid + " is dropped: price " + strconv.FormatFloat(price, 'f', -1, 64) +
+			" " + cur + " is below the bid floor " + strconv.FormatFloat(bidFloor, 'f', -1, 64) + " " + cur + " of imp " + imp.ID)
+		return true, nil
+	}
+	return false, nil
+}
+
+// getBidFromContent: build openrtb2.Bid from one huaweiads content
+func getBidFromContent(adType int32, content *content, imp *openrtb2.Imp) (openrtb2.Bid, error) {
+	bid := openrtb2.Bid{
+		ID:    imp.ID + ":" + content.Contentid,
+		ImpID: imp.ID,
+		Price: content.Price,
+		CrID:  content.Contentid,
+		MType: getMarkupType(adType, content.Creativetype, imp),
+	}
+	bid.W, bid.H = getContentSize(content)
+	if content.MetaData.ApkInfo.PackageName != "" {
+		bid.Bundle = content.MetaData.ApkInfo.PackageName
+	}
+
+	var err error
+	switch bid.MType {
+	case openrtb2.MarkupNative:
+		bid.AdM, err = getNativeAdm(content, imp)
+	case openrtb2.MarkupVideo:
+		var version = vastVersion3
+		if imp.Video != nil {
+			version = getVastVersion(imp.Video.Protocols)
+		}
+		bid.AdM, err = getVastAdm(content, version)
+	case openrtb2.MarkupAudio:
+		if imp.Audio == nil {
+			return bid, errors.New("generate audio adm failed: imp.Audio is empty, imp id: " + imp.ID)
+		}
+		bid.AdM, err = getAudioAdm(content, imp.Audio.Protocols)
+	case openrtb2.MarkupBanner:
+		bid.AdM, bid.W, bid.H, err = getBannerAdm(content, imp)
+	}
+	if err != nil {
+		return bid, err
+	}
+	return bid, nil
+}
+
+// getMarkupType: the markup type of bid, decided by huaweiads adtype, creativetype and the formats the imp offers
+func getMarkupType(adType int32, creativeType int32, imp *openrtb2.Imp) openrtb2.MarkupType {
+	switch adType {
+	case native:
+		return openrtb2.MarkupNative
+	case roll:
+		return openrtb2.MarkupVideo
+	case audio:
+		return openrtb2.MarkupAudio
+	case banner, interstitial, rewarded:
+		if isVideoCreativeType(creativeType) && (isVideoOffered(adType, imp) || imp.Banner == nil) {
+			return openrtb2.MarkupVideo
+		}
+		return openrtb2.MarkupBanner
+	default:
+		return openrtb2.MarkupBanner
+	}
+}
+
+// isVideoOffered: an imp.Video which fails the video checks was not requested from huaweiads
+func isVideoOffered(adType int32, imp *openrtb2.Imp) bool {
+	return imp.Video != nil && getVideoFormat(&adslot30{}, adType, imp) == nil
+}
+
+func isVideoCreativeType(creativeType int32) bool {
+	return creativeType == video || creativeType == videoText || creativeType == videoWithPicturesText
+}
+
+// getContentSize: width and height of the creative, video first, then the first image
+func getContentSize(content *content) (w int64, h int64) {
+	if content.MetaData.VideoInfo.Width != 0 && content.MetaData.VideoInfo.Height != 0 {
+		return int64(content.MetaData.VideoInfo.Width), int64(content.MetaData.VideoInfo.Height)
+	}
+	if len(content.MetaData.ImageInfo) > 0 {
+		return content.MetaData.ImageInfo[0].Width, content.MetaData.ImageInfo[0].Height
+	}
+	return 0, 0
+}
+
+// getNativeAdm: build OpenRTB Native 1.2 response, the assets are filled by the asset ids of imp.Native.Request
+func getNativeAdm(content *content, imp *openrtb2.Imp) (string, error) {
+	model, err := getNativeAssetModel(imp)
+	if err != nil {
+		return "", errors.New("generate native adm failed, imp id: " + imp.ID + ", error: " + err.Error())
+	}
+
+	var nativeResult = nativeResponse.Response{
+		Ver: nativeResponseVersion,
+		Link: nativeResponse.Link{
+			URL:           getClickThroughUrl(content),
+			ClickTrackers: getMonitorUrls(content, clickEventType),
+		},
+	}
+	for _, url := range getMonitorUrls(content, impEventType) {
+		nativeResult.EventTrackers = append(nativeResult.EventTrackers, nativeResponse.EventTracker{
+			Event:  native1.EventTypeImpression,
+			Method: native1.EventTrackingMethodImage,
+			URL:    url,
+		})
+	}
+
+	var imgIndex = 0
+	var iconIndex = 0
+	for _, asset := range model.assets {
+		var assetId = asset.ID
+		var responseAsset = nativeResponse.Asset{ID: &assetId}
+		var filled = false
+		if asset.Title != nil {
+			if title := truncateTitle(content.MetaData.Title, asset.Title.Len); title != "" {
+				responseAsset.Title = &nativeResponse.Title{
+					Text: title,
+					Len:  int64(len([]rune(title))),
+				}
+				filled = true
+			}
+		} else if asset.Video != nil {
+			if isVideoCreativeType(content.Creativetype) {
+				vastTag, err := getVastAdm(content, getVastVersion(asset.Video.Protocols))
+				if err != nil {
+					return "", err
+				}
+				responseAsset.Video = &nativeResponse.Video{VASTTag: vastTag}
+				filled = true
+			}
+		} else if asset.Img != nil {
+			if asset.Img.Type == native1.ImageAssetTypeIcon || asset.Img.Type == native1.ImageAssetTypeLogo {
+				if iconIndex < len(content.MetaData.Icon) {
+					var appIcon = content.MetaData.Icon[iconIndex]
+					iconIndex++
+					responseAsset.Img = &nativeResponse.Image{
+						Type: asset.Img.Type,
+						URL:  appIcon.Url,
+						W:    appIcon.Width,
+						H:    appIcon.Height,
+					}
+					filled = true
+				}
+			} else if imgIndex < len(content.MetaData.ImageInfo) {
+				var image = content.MetaData.ImageInfo[imgIndex]
+				imgIndex++
+				responseAsset.Img = &nativeResponse.Image{
+					Type: native1.ImageAssetTypeMain,
+					URL:  image.Url,
+					W:    image.Width,
+					H:    image.Height,
+				}
+				filled = true
+			}
+		} else if asset.Data != nil {
+			if value := getNativeDataValue(content, asset.Data.Type); value != "" {
+				responseAsset.Data = &nativeResponse.Data{
+					Type:  asset.Data.Type,
+					Value: value,
+				}
+				filled = true
+			}
+		}
+
+		if !filled {
+			if asset.Required == 1 {
+				return "", errors.New("generate native adm failed: required asset can't be filled, asset id: " +
+					strconv.FormatInt(asset.ID, 10) + ", content id: " + content.Contentid)
+			}
+			continue
+		}
+		nativeResult.Assets = append(nativeResult.Assets, responseAsset)
+	}
+
+	result, err := json.Marshal(nativeResult)
+	if err != nil {
+		return "", err
+	}
+	return string(result), nil
+}
+
+// truncateTitle: title.len is the max length of the title, 0 means no limit
+func truncateTitle(title string, maxLen int64) string {
+	var runes = []rune(title)
+	if maxLen > 0 && int64(len(runes)) > maxLen {
+		return string(runes[:maxLen])
+	}
+	return title
+}
+
+func getNativeDataValue(content *content, dataType native1.DataAssetType) string {
+	switch dataType {
+	case native1.DataAssetTypeDesc, native1.DataAssetTypeDesc2:
+		return content.MetaData.Description
+	case native1.DataAssetTypeSponsored:
+		return content.MetaData.ApkInfo.AppName
+	case native1.DataAssetTypeCTAText:
+		if content.Interactiontype == appPromotion {
+			return "Install"
+		}
+		return "Learn more"
+	default:
+		return ""
+	}
+}
+
+// getClickThroughUrl: clickUrl first, intent is used for deeplink ads
+func getClickThroughUrl(content *content) string {
+	if content.MetaData.ClickUrl != "" {
+		return content.MetaData.ClickUrl
+	}
+	return content.MetaData.Intent
+}
+
+// getMonitorUrls: all the monitor urls of one eventType
+func getMonitorUrls(content *content, eventType string) []string {
+	var urls []string
+	for _, monitor := range content.Monitor {
+		if monitor.EventType == eventType {
+			urls = append(urls, monitor.Url...)
+		}
+	}
+	return urls
+}
@@ -0,0 +1,420 @@
+package adapters
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	openrtb2 "github.com/prebid/openrtb/v17/openrtb2"
+	"github.com/prebid/openrtb/v17/openrtb3"
+	currency "main.go/currency"
+)
+
+// newTestAdapter: an adapter with the rates of the mock, 7.1 CNY for 1 USD
+func newTestAdapter(t *testing.T) *adapter {
+	converter, err := currency.NewConverter("../cmd/huaweiadxmock/rates.json")
+	if err != nil {
+		t.Fatalf("NewConverter: %v", err)
+	}
+	return &adapter{currencyConverter: converter, huaweiVendorID: 10, defaultCountry: defaultCountryName}
+}
+
+func TestIsBelowBidFloor(t *testing.T) {
+	tests := []struct {
+		name        string
+		price       float64
+		cur         string
+		bidFloor    float64
+		bidFloorCur string
+		below       bool
+		err         bool
+	}{
+		{name: "no floor", price: 0.01, cur: "USD"},
+		{name: "above the floor", price: 1.5, cur: "USD", bidFloor: 1},
+		{name: "equal to the floor", price: 1, cur: "USD", bidFloor: 1, bidFloorCur: "USD"},
+		{name: "below the floor", price: 0.5, cur: "USD", bidFloor: 1, below: true},
+		{name: "below a converted floor", price: 0.5, cur: "USD", bidFloor: 7.1, bidFloorCur: "CNY", below: true},
+		{name: "above a converted floor", price: 1.5, cur: "USD", bidFloor: 7.1, bidFloorCur: "cny"},
+		{name: "floor currency without rate", price: 100, cur: "USD", bidFloor: 1, bidFloorCur: "XXX", err: true},
+	}
+	bidder := newTestAdapter(t)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			imp := openrtb2.Imp{ID: "imp", BidFloor: test.bidFloor, BidFloorCur: test.bidFloorCur}
+			below, err := bidder.isBelowBidFloor(test.price, test.cur, &content{Contentid: "content"}, &imp)
+			if (err != nil) != test.err {
+				t.Fatalf("isBelowBidFloor error = %v, want error %v", err, test.err)
+			}
+			if below != test.below {
+				t.Errorf("isBelowBidFloor = %v, want %v", below, test.below)
+			}
+		})
+	}
+}
+
+func newTestImp(id string, slotid string, adtype string) openrtb2.Imp {
+	return openrtb2.Imp{
+		ID: id,
+		Ext: json.RawMessage(`{"bidder":{"slotid":"` + slotid + `","adtype":"` + adtype +
+			`","publisherid":"123","signkey":"signkey","keyid":"41"}}`),
+	}
+}
+
+func newTestBannerImp(id string, slotid string) openrtb2.Imp {
+	var w, h int64 = 300, 250
+	imp := newTestImp(id, slotid, "banner")
+	imp.Banner = &openrtb2.Banner{W: &w, H: &h}
+	return imp
+}
+
+// newImageContent: a 300x250 picture priced in cur
+func newImageContent(contentid string, price float64, cur string) content {
+	return content{
+		Contentid:    contentid,
+		Creativetype: bigPicture,
+		MetaData: metaData{
+			Title:     "Huawei banner",
+			ClickUrl:  "https://ads.huawei.com/landing",
+			ImageInfo: []imageInfo{{Url: "https://ads.huawei.com/" + contentid + ".jpg", Width: 300, Height: 250}},
+		},
+		Monitor: []monitor{{EventType: impEventType, Url: []string{"https://events.huawei.com/imp"}}},
+		Price:   price,
+		Cur:     cur,
+	}
+}
+
+func TestConvertHuaweiAdsRespToBidResponse(t *testing.T) {
+	tests := []struct {
+		name     string
+		imps     []openrtb2.Imp
+		multiad  []ad30
+		bids     map[string]float64 // bid id -> price in USD
+		bidImps  map[string]string  // bid id -> imp id
+		nbr      *openrtb3.NoBidReason
+		errCount int
+	}{
+		{
+			name:    "no multiad",
+			imps:    []openrtb2.Imp{newTestBannerImp("imp1", "s1")},
+			nbr:     NoBidNoFill.Ptr(),
+			bids:    map[string]float64{},
+			bidImps: map[string]string{},
+		},
+		{
+			name:    "one bid converted to the request currency",
+			imps:    []openrtb2.Imp{newTestBannerImp("imp1", "s1")},
+			multiad: []ad30{{AdType: banner, Slotid: "s1", Retcode30: 200, Content: []content{newImageContent("c1", 7.1, "CNY")}}},
+			bids:    map[string]float64{"imp1:c1": 1},
+			bidImps: map[string]string{"imp1:c1": "imp1"},
+		},
+		{
+			name: "imps sharing a slotid are paired in order",
+			imps: []openrtb2.Imp{newTestBannerImp("imp1", "s1"), newTestBannerImp("imp2", "s1")},
+			multiad: []ad30{
+				{AdType: banner, Slotid: "s1", Retcode30: 200, Content: []content{newImageContent("c1", 1, "USD")}},
+				{AdType: banner, Slotid: "s1", Retcode30: 200, Content: []content{newImageContent("c2", 2, "USD")}},
+			},
+			bids:    map[string]float64{"imp1:c1": 1, "imp2:c2": 2},
+			bidImps: map[string]string{"imp1:c1": "imp1", "imp2:c2": "imp2"},
+		},
+		{
+			name: "more ad30 than imps",
+			imps: []openrtb2.Imp{newTestBannerImp("imp1", "s1")},
+			multiad: []ad30{
+				{AdType: banner, Slotid: "s1", Retcode30: 200, Content: []content{newImageContent("c1", 1, "USD")}},
+				{AdType: banner, Slotid: "s1", Retcode30: 200, Content: []content{newImageContent("c2", 2, "USD")}},
+			},
+			bids:     map[string]float64{"imp1:c1": 1},
+			bidImps:  map[string]string{"imp1:c1": "imp1"},
+			errCount: 1,
+		},
+		{
+			name:     "unknown slotid",
+			imps:     []openrtb2.Imp{newTestBannerImp("imp1", "s1")},
+			multiad:  []ad30{{AdType: banner, Slotid: "s2", Retcode30: 200, Content: []content{newImageContent("c1", 1, "USD")}}},
+			nbr:      NoBidNoFill.Ptr(),
+			bids:     map[string]float64{},
+			bidImps:  map[string]string{},
+			errCount: 1,
+		},
+		{
+			name:    "slot without fill",
+			imps:    []openrtb2.Imp{newTestBannerImp("imp1", "s1")},
+			multiad: []ad30{{AdType: banner, Slotid: "s1", Retcode30: 204}},
+			nbr:     NoBidNoFill.Ptr(),
+			bids:    map[string]float64{},
+			bidImps: map[string]string{},
+		},
+		{
+			name: "slot not found next to a filled slot",
+			imps: []openrtb2.Imp{newTestBannerImp("imp1", "s1"), newTestBannerImp("imp2", "s2")},
+			multiad: []ad30{
+				{AdType: banner, Slotid: "s1", Retcode30: 404},
+				{AdType: banner, Slotid: "s2", Retcode30: 200, Content: []content{newImageContent("c2", 2, "USD")}},
+			},
+			bids:     map[string]float64{"imp2:c2": 2},
+			bidImps:  map[string]string{"imp2:c2": "imp2"},
+			errCount: 1,
+		},
+		{
+			name:     "slot not found",
+			imps:     []openrtb2.Imp{newTestBannerImp("imp1", "s1")},
+			multiad:  []ad30{{AdType: banner, Slotid: "s1", Retcode30: 404}},
+			nbr:      NoBidSlotNotConfigured.Ptr(),
+			bids:     map[string]float64{},
+			bidImps:  map[string]string{},
+			errCount: 1,
+		},
+		{
+			name:     "currency without rate",
+			imps:     []openrtb2.Imp{newTestBannerImp("imp1", "s1")},
+			multiad:  []ad30{{AdType: banner, Slotid: "s1", Retcode30: 200, Content: []content{newImageContent("c1", 1, "XXX")}}},
+			nbr:      NoBidCurrencyNotConvertible.Ptr(),
+			bids:     map[string]float64{},
+			bidImps:  map[string]string{},
+			errCount: 1,
+		},
+		{
+			name: "content below the floor",
+			imps: func() []openrtb2.Imp {
+				imp := newTestBannerImp("imp1", "s1")
+				imp.BidFloor = 1.5
+				return []openrtb2.Imp{imp}
+			}(),
+			multiad: []ad30{{AdType: banner, Slotid: "s1", Retcode30: 200, Content: []content{
+				newImageContent("c1", 1, "USD"), newImageContent("c2", 2, "USD"),
+			}}},
+			bids:    map[string]float64{"imp1:c2": 2},
+			bidImps: map[string]string{"imp1:c2": "imp1"},
+		},
+	}
+	bidder := newTestAdapter(t)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := &openrtb2.BidRequest{ID: "request", Imp: test.imps, Cur: []string{"USD"}}
+			bidResponse, errs := bidder.convertHuaweiAdsRespToBidResponse(request, &huaweiAdsResponse{Retcode: 200, Multiad: test.multiad})
+			if len(errs) != test.errCount {
+				t.Errorf("got errors %v, want %d", errs, test.errCount)
+			}
+			if bidResponse.ID != "request" || bidResponse.Cur != "USD" {
+				t.Errorf("bid response id %q cur %q, want request USD", bidResponse.ID, bidResponse.Cur)
+			}
+			if !reflect.DeepEqual(bidResponse.NBR, test.nbr) {
+				t.Errorf("NBR = %v, want %v", nbrString(bidResponse.NBR), nbrString(test.nbr))
+			}
+			var prices = map[string]float64{}
+			var bidImps = map[string]string{}
+			for _, seatBid := range bidResponse.SeatBid {
+				if seatBid.Seat != huaweiAdsSeat {
+					t.Errorf("seat = %q, want %q", seatBid.Seat, huaweiAdsSeat)
+				}
+				for _, bid := range seatBid.Bid {
+					prices[bid.ID] = math.Round(bid.Price*1e6) / 1e6
+					bidImps[bid.ID] = bid.ImpID
+					if bid.MType != openrtb2.MarkupBanner || bid.AdM == "" || bid.W != 300 || bid.H != 250 {
+						t.Errorf("bid %s: mtype %d, size %dx%d, adm %q, want a 300x250 banner", bid.ID, bid.MType, bid.W, bid.H, bid.AdM)
+					}
+				}
+			}
+			if !reflect.DeepEqual(prices, test.bids) {
+				t.Errorf("bid prices = %v, want %v", prices, test.bids)
+			}
+			if !reflect.DeepEqual(bidImps, test.bidImps) {
+				t.Errorf("bid imps = %v, want %v", bidImps, test.bidImps)
+			}
+		})
+	}
+}
+
+func nbrString(nbr *openrtb3.NoBidReason) string {
+	if nbr == nil {
+		return "nil"
+	}
+	return strconv.Itoa(int(*nbr))
+}
+
+func TestMakeBids(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		nbr      openrtb3.NoBidReason
+		retcode  bool
+		errCount int
+	}{
+		{name: "malformed body", body: `{"retcode":`, nbr: NoBidMalformedResponse, errCount: 1},
+		{name: "no ads", body: `{"retcode":204,"reason":"no ads"}`, nbr: NoBidNoFill},
+		{name: "signature rejected", body: `{"retcode":401,"reason":"bad signature"}`, nbr: NoBidSignatureRejected, retcode: true, errCount: 1},
+		{name: "server error", body: `{"retcode":503}`, nbr: openrtb3.NoBidTechnicalError, retcode: true, errCount: 1},
+		{name: "success without multiad", body: `{"retcode":200}`, nbr: NoBidNoFill},
+	}
+	bidder := newTestAdapter(t)
+	request := &openrtb2.BidRequest{ID: "request", Imp: []openrtb2.Imp{newTestBannerImp("imp1", "s1")}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bidResponse, errs := bidder.MakeBids(request, []byte(test.body))
+			if len(errs) != test.errCount {
+				t.Fatalf("got errors %v, want %d", errs, test.errCount)
+			}
+			if bidResponse == nil || bidResponse.NBR == nil || *bidResponse.NBR != test.nbr {
+				t.Fatalf("bid response %+v, want NBR %d", bidResponse, test.nbr)
+			}
+			if test.retcode {
+				if _, isRetcodeErr := errs[0].(*RetcodeError); !isRetcodeErr {
+					t.Errorf("error %v is not a *RetcodeError", errs[0])
+				}
+			}
+		})
+	}
+
+	body := `{"retcode":200,"multiad":[{"adtype":8,"slotid":"s1","retcode30":200,"content":[{"contentid":"c1",` +
+		`"creativetype":2,"price":2,"cur":"USD","metaData":{"imageInfo":[{"url":"https://ads.huawei.com/c1.jpg",` +
+		`"width":300,"height":250}]},"monitor":[{"eventType":"imp","url":["https://events.huawei.com/imp"]}]}]}]}`
+	bidResponse, errs := bidder.MakeBids(request, []byte(body))
+	if len(errs) > 0 {
+		t.Fatalf("MakeBids errors: %v", errs)
+	}
+	if bidResponse.NBR != nil || len(bidResponse.SeatBid) != 1 || len(bidResponse.SeatBid[0].Bid) != 1 {
+		t.Fatalf("bid response %+v, want one bid", bidResponse)
+	}
+	if bid := bidResponse.SeatBid[0].Bid[0]; bid.ImpID != "imp1" || bid.CrID != "c1" || bid.Price != 2 {
+		t.Errorf("bid %+v, want imp1, c1, price 2", bid)
+	}
+}
+
+// newNativeContent: an app promotion with a title, a main image, an icon and a description
+func newNativeContent() *content {
+	return &content{
+		Contentid:       "c1",
+		Interactiontype: appPromotion,
+		Creativetype:    bigPicture,
+		MetaData: metaData{
+			Title:       "Huawei 应用市场",
+			Description: "Download the app",
+			ClickUrl:    "https://ads.huawei.com/landing",
+			ImageInfo:   []imageInfo{{Url: "https://ads.huawei.com/main.jpg", Width: 720, Height: 1280}},
+			Icon:        []icon{{Url: "https://ads.huawei.com/icon.png", Width: 160, Height: 160}},
+			ApkInfo:     apkInfo{AppName: "AppGallery"},
+		},
+		Monitor: []monitor{
+			{EventType: impEventType, Url: []string{"https://events.huawei.com/imp"}},
+			{EventType: clickEventType, Url: []string{"https://events.huawei.com/click"}},
+		},
+	}
+}
+
+func newNativeImp(request string) *openrtb2.Imp {
+	return &openrtb2.Imp{ID: "imp1", Native: &openrtb2.Native{Request: request}}
+}
+
+func TestGetNativeAdm(t *testing.T) {
+	tests := []struct {
+		name    string
+		request string
+		content *content
+		assets  string
+		err     bool
+	}{
+		{
+			name: "every asset type keeps its request id",
+			request: `{"ver":"1.2","assets":[{"id":7,"required":1,"title":{"len":90}},` +
+				`{"id":3,"required":1,"img":{"type":3,"w":720,"h":1280}},{"id":9,"img":{"type":1}},` +
+				`{"id":4,"data":{"type":2}},{"id":5,"data":{"type":1}},{"id":6,"data":{"type":12}}]}`,
+			content: newNativeContent(),
+			assets: `[{"id":7,"title":{"text":"Huawei 应用市场","len":11}},` +
+				`{"id":3,"img":{"type":3,"url":"https://ads.huawei.com/main.jpg","w":720,"h":1280}},` +
+				`{"id":9,"img":{"type":1,"url":"https://ads.huawei.com/icon.png","w":160,"h":160}},` +
+				`{"id":4,"data":{"type":2,"value":"Download the app"}},` +
+				`{"id":5,"data":{"type":1,"value":"AppGallery"}},` +
+				`{"id":6,"data":{"type":12,"value":"Install"}}]`,
+		},
+		{
+			name:    "title is truncated by runes",
+			request: `{"ver":"1.2","assets":[{"id":1,"title":{"len":8}}]}`,
+			content: newNativeContent(),
+			assets:  `[{"id":1,"title":{"text":"Huawei 应","len":8}}]`,
+		},
+		{
+			name:    "optional asset without content is left out",
+			request: `{"ver":"1.2","assets":[{"id":1,"title":{"len":90}},{"id":2,"img":{"type":3}},{"id":3,"img":{"type":3}}]}`,
+			content: newNativeContent(),
+			assets: `[{"id":1,"title":{"text":"Huawei 应用市场","len":11}},` +
+				`{"id":2,"img":{"type":3,"url":"https://ads.huawei.com/main.jpg","w":720,"h":1280}}]`,
+		},
+		{
+			name:    "required asset without content",
+			request: `{"ver":"1.2","assets":[{"id":1,"title":{"len":90}},{"id":2,"required":1,"img":{"type":3}},{"id":3,"required":1,"img":{"type":3}}]}`,
+			content: newNativeContent(),
+			err:     true,
+		},
+		{
+			name:    "required video for a picture content",
+			request: `{"ver":"1.2","assets":[{"id":1,"required":1,"video":{"mimes":["video/mp4"],"protocols":[3]}}]}`,
+			content: newNativeContent(),
+			err:     true,
+		},
+		{
+			name:    "invalid native request",
+			request: `{"ver":"1.2","assets":`,
+			content: newNativeContent(),
+			err:     true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			adm, err := getNativeAdm(test.content, newNativeImp(test.request))
+			if test.err {
+				if err == nil {
+					t.Fatalf("getNativeAdm = %s, want an error", adm)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("getNativeAdm: %v", err)
+			}
+			var response struct {
+				Ver           string                 `json:"ver"`
+				Assets        json.RawMessage        `json:"assets"`
+				Link          map[string]interface{} `json:"link"`
+				EventTrackers []json.RawMessage      `json:"eventtrackers"`
+			}
+			if err := json.Unmarshal([]byte(adm), &response); err != nil {
+				t.Fatalf("adm is not json: %v, %s", err, adm)
+			}
+			if response.Ver != nativeResponseVersion || len(response.EventTrackers) != 1 {
+				t.Errorf("adm = %s, want version %s and one impression tracker", adm, nativeResponseVersion)
+			}
+			if response.Link["url"] != "https://ads.huawei.com/landing" {
+				t.Errorf("link = %v, want the click url", response.Link)
+			}
+			if string(response.Assets) != test.assets {
+				t.Errorf("assets = %s, want %s", response.Assets, test.assets)
+			}
+		})
+	}
+}
+
+func TestGetNativeAdmVideo(t *testing.T) {
+	request := `{"ver":"1.2","assets":[{"id":2,"required":1,"video":{"mimes":["video/mp4"],"protocols":[7]}}]}`
+	adm, err := getNativeAdm(newVideoContent(), newNativeImp(request))
+	if err != nil {
+		t.Fatalf("getNativeAdm: %v", err)
+	}
+	var response struct {
+		Assets []struct {
+			ID    int64 `json:"id"`
+			Video struct {
+				VASTTag string `json:"vasttag"`
+			} `json:"video"`
+		} `json:"assets"`
+	}
+	if err := json.Unmarshal([]byte(adm), &response); err != nil {
+		t.Fatalf("adm is not json: %v", err)
+	}
+	if len(response.Assets) != 1 || response.Assets[0].ID != 2 ||
+		!strings.Contains(response.Assets[0].Video.VASTTag, `<VAST version="4.0">`) {
+		t.Errorf("adm = %s, want asset 2 with a VAST 4 tag", adm)
+	}
+}
@@ -0,0 +1,84 @@
+package adapters
+
+import (
+	"encoding/json"
+	"log"
+	"strconv"
+
+	openrtb2 "github.com/prebid/openrtb/v17/openrtb2"
+	"main.go/gpp"
+)
+
+// getUsPrivacyString: regs.us_privacy first, then regs.ext.us_privacy
+func getUsPrivacyString(openRTBRequest *openrtb2.BidRequest) string {
+	if openRTBRequest.Regs == nil {
+		return ""
+	}
+	if openRTBRequest.Regs.USPrivacy != "" {
+		return openRTBRequest.Regs.USPrivacy
+	}
+	if openRTBRequest.Regs.Ext != nil {
+		var extRegs ExtRegs
+		if err := json.Unmarshal(openRTBRequest.Regs.Ext, &extRegs); err == nil {
+			return extRegs.USPrivacy
+		}
+	}
+	return ""
+}
+
+// isUsPrivacyOptOut: CCPA string version 1, notice, opt-out of sale, LSPA covered, like "1YYN"
+func isUsPrivacyOptOut(usPrivacy string) bool {
+	return len(usPrivacy) == 4 && usPrivacy[0] == '1' && (usPrivacy[2] == 'Y' || usPrivacy[2] == 'y')
+}
+
+// getUsPrivacyOptOut: the us_privacy string, then the uspv1 and usnat sections of regs.gpp, a gpp section only
+// counts when regs.gpp_sid is empty or includes it
+func getUsPrivacyOptOut(openRTBRequest *openrtb2.BidRequest) (bool, string) {
+	if usPrivacy := getUsPrivacyString(openRTBRequest); isUsPrivacyOptOut(usPrivacy) {
+		return true, "us_privacy " + usPrivacy + " opts out of sale"
+	}
+	if openRTBRequest.Regs == nil || openRTBRequest.Regs.GPP == "" {
+		return false, ""
+	}
+	gppSid := openRTBRequest.Regs.GPPSID
+	if !isGppSectionApplicable(gppSid, gpp.SectionUSPV1) && !isGppSectionApplicable(gppSid, gpp.SectionUSNat) {
+		return false, ""
+	}
+	parsedGpp, err := gpp.Parse(openRTBRequest.Regs.GPP)
+	if err != nil {
+		log.Println("parse gpp of request " + openRTBRequest.ID + " failed: " + err.Error())
+		return false, ""
+	}
+	if usPrivacy, found := parsedGpp.Sections[gpp.SectionUSPV1]; found && isGppSectionApplicable(gppSid, gpp.SectionUSPV1) &&
+		isUsPrivacyOptOut(usPrivacy) {
+		return true, "gpp section " + strconv.Itoa(gpp.SectionUSPV1) + " " + usPrivacy + " opts out of sale"
+	}
+	if section, found := parsedGpp.Sections[gpp.SectionUSNat]; found && isGppSectionApplicable(gppSid, gpp.SectionUSNat) {
+		usNat, err := gpp.ParseUSNat(section)
+		if err != nil {
+			log.Println("parse gpp usnat section of request " + openRTBRequest.ID + " failed: " + err.Error())
+			return false, ""
+		}
+		if usNat.SaleOptOut == gpp.USNatOptedOut {
+			return true, "gpp section " + strconv.Itoa(gpp.SectionUSNat) + " " + section + " opts out of sale"
+		}
+	}
+	return false, ""
+}
+
+func isGppSectionApplicable(gppSid []int8, sectionID int) bool {
+	if len(gppSid) == 0 {
+		return true
+	}
+	for _, sid := range gppSid {
+		if int(sid) == sectionID {
+			return true
+		}
+	}
+	return false
+}
+
+// logUsPrivacyOptOut: one line per opted out request for audits
+func logUsPrivacyOptOut(openRTBRequest *openrtb2.BidRequest, reason string) {
+	log.Println("US privacy audit: request id: " + openRTBRequest.ID + ", decision: strip personal data, reason: " + reason)
+}
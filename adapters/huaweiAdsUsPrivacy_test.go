@@ -0,0 +1,35 @@
+package adapters
+
+import (
+	"encoding/json"
+	"testing"
+
+	openrtb2 "github.com/prebid/openrtb/v17/openrtb2"
+)
+
+func TestGetUsPrivacyOptOut(t *testing.T) {
+	tests := []struct {
+		name   string
+		regs   *openrtb2.Regs
+		optOut bool
+	}{
+		{name: "no regs"},
+		{name: "us_privacy opt-out", regs: &openrtb2.Regs{USPrivacy: "1YYN"}, optOut: true},
+		{name: "us_privacy no opt-out", regs: &openrtb2.Regs{USPrivacy: "1YNN"}},
+		{name: "ext us_privacy opt-out", regs: &openrtb2.Regs{Ext: json.RawMessage(`{"us_privacy":"1YYY"}`)}, optOut: true},
+		{name: "gpp uspv1 opt-out", regs: &openrtb2.Regs{GPP: "DBABTA~1YYN"}, optOut: true},
+		{name: "gpp uspv1 not in gpp_sid", regs: &openrtb2.Regs{GPP: "DBABTA~1YYN", GPPSID: []int8{2}}},
+		{name: "gpp usnat opt-out", regs: &openrtb2.Regs{GPP: "DBABLA~BVVaAAEABCA", GPPSID: []int8{7}}, optOut: true},
+		{name: "gpp usnat no opt-out", regs: &openrtb2.Regs{GPP: "DBABLA~BVVqAAEABCA.QA"}},
+		{name: "gpp usnat not in gpp_sid", regs: &openrtb2.Regs{GPP: "DBABLA~BVVaAAEABCA", GPPSID: []int8{6}}},
+		{name: "invalid gpp", regs: &openrtb2.Regs{GPP: "DBABLA"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			optOut, reason := getUsPrivacyOptOut(&openrtb2.BidRequest{ID: "us-privacy", Regs: test.regs})
+			if optOut != test.optOut {
+				t.Errorf("getUsPrivacyOptOut = %v (%s), want %v", optOut, reason, test.optOut)
+			}
+		})
+	}
+}
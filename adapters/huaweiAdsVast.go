@@ -0,0 +1,250 @@
+package adapters
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+
+	"github.com/prebid/openrtb/v17/adcom1"
+)
+
+const vastVersion3 = "3.0"
+const vastVersion4 = "4.0"
+const daastVersion1 = "1.0"
+const vastRootName = "VAST"
+const daastRootName = "DAAST"
+const vastAdSystem = "HuaweiAds"
+const defaultVideoMime = "video/mp4"
+const defaultAudioMime = "audio/mpeg"
+
+// huaweiads monitor eventType -> VAST tracking events
+var vastTrackingEvents = map[string][]string{
+	"videoStart":    {"start"},
+	"playStart":     {"start"},
+	"firstQuartile": {"firstQuartile"},
+	"midpoint":      {"midpoint"},
+	"thirdQuartile": {"thirdQuartile"},
+	"playEnd":       {"complete"},
+	"complete":      {"complete"},
+	"playPause":     {"pause"},
+	"playResume":    {"resume"},
+	"soundClickOff": {"mute"},
+	"soundClickOn":  {"unmute"},
+	"userclose":     {"skip", "closeLinear"},
+}
+
+// vast: XMLName is VAST or DAAST
+type vast struct {
+	XMLName xml.Name
+	Version string `xml:"version,attr"`
+	Ad      vastAd `xml:"Ad"`
+}
+
+type vastAd struct {
+	ID     string     `xml:"id,attr"`
+	InLine vastInLine `xml:"InLine"`
+}
+
+type vastInLine struct {
+	AdSystem    string         `xml:"AdSystem"`
+	AdTitle     string         `xml:"AdTitle"`
+	Description *vastCData     `xml:"Description,omitempty"`
+	Error       []vastCData    `xml:"Error,omitempty"`
+	Impression  []vastCData    `xml:"Impression"`
+	Creatives   []vastCreative `xml:"Creatives>Creative"`
+}
+
+// vastCreative: VAST 3 and DAAST spell the ad id attribute AdID, VAST 4 adId, only one of them is set
+type vastCreative struct {
+	ID            string             `xml:"id,attr"`
+	AdID          string             `xml:"AdID,attr,omitempty"`
+	AdId          string             `xml:"adId,attr,omitempty"`
+	UniversalAdId *vastUniversalAdId `xml:"UniversalAdId,omitempty"`
+	Linear        vastLinear         `xml:"Linear"`
+}
+
+type vastUniversalAdId struct {
+	IdRegistry string `xml:"idRegistry,attr"`
+	Value      string `xml:",chardata"`
+}
+
+type vastLinear struct {
+	Duration          string          `xml:"Duration"`
+	TrackingEvents    []vastTracking  `xml:"TrackingEvents>Tracking,omitempty"`
+	VideoClicks       *vastVideoClick `xml:"VideoClicks,omitempty"`
+	AudioInteractions *vastVideoClick `xml:"AudioInteractions,omitempty"`
+	MediaFiles        []vastMediaFile `xml:"MediaFiles>MediaFile"`
+}
+
+type vastTracking struct {
+	Event string `xml:"event,attr"`
+	Url   string `xml:",cdata"`
+}
+
+type vastVideoClick struct {
+	ClickThrough  *vastCData  `xml:"ClickThrough,omitempty"`
+	ClickTracking []vastCData `xml:"ClickTracking,omitempty"`
+}
+
+type vastMediaFile struct {
+	Delivery string `xml:"delivery,attr"`
+	Type     string `xml:"type,attr"`
+	Width    int64  `xml:"width,attr,omitempty"`
+	Height   int64  `xml:"height,attr,omitempty"`
+	Url      string `xml:",cdata"`
+}
+
+type vastCData struct {
+	Value string `xml:",cdata"`
+}
+
+// getVastVersion: VAST 4 when the request only accepts VAST 4.x, otherwise VAST 3
+func getVastVersion(protocols []adcom1.MediaCreativeSubtype) string {
+	if len(protocols) == 0 {
+		return vastVersion3
+	}
+	for _, protocol := range protocols {
+		if protocol == adcom1.CreativeVAST30 || protocol == adcom1.CreativeVAST30Wrapper {
+			return vastVersion3
+		}
+	}
+	for _, protocol := range protocols {
+		switch protocol {
+		case adcom1.CreativeVAST40, adcom1.CreativeVAST40Wrapper, adcom1.CreativeVAST41,
+			adcom1.CreativeVAST41Wrapper, adcom1.CreativeVAST42, adcom1.CreativeVAST42Wrapper:
+			return vastVersion4
+		}
+	}
+	return vastVersion3
+}
+
+// getAudioAdm: DAAST when the request only accepts DAAST, otherwise an audio VAST
+func getAudioAdm(content *content, protocols []adcom1.MediaCreativeSubtype) (string, error) {
+	var daastOnly = len(protocols) > 0
+	for _, protocol := range protocols {
+		if protocol != adcom1.CreativeDAAST10 && protocol != adcom1.CreativeDAAST10Wrapper {
+			daastOnly = false
+		}
+	}
+	mediaFile := getVastMediaFile(content, defaultAudioMime)
+	if mediaFile.Url == "" {
+		return "", errors.New("generate audio adm failed: content has no audio url, content id: " + content.Contentid)
+	}
+	if daastOnly {
+		return buildVastXml(content, daastRootName, daastVersion1, mediaFile)
+	}
+	return buildVastXml(content, vastRootName, getVastVersion(protocols), mediaFile)
+}
+
+// getVastAdm: build an InLine VAST from huaweiads video content
+func getVastAdm(content *content, version string) (string, error) {
+	mediaFile := getVastMediaFile(content, defaultVideoMime)
+	if mediaFile.Url == "" {
+		return "", errors.New("generate VAST adm failed: content has no video url, content id: " + content.Contentid)
+	}
+	return buildVastXml(content, vastRootName, version, mediaFile)
+}
+
+func buildVastXml(content *content, rootName string, version string, mediaFile vastMediaFile) (string, error) {
+	var inLine = vastInLine{
+		AdSystem: vastAdSystem,
+		AdTitle:  content.MetaData.Title,
+	}
+	if content.MetaData.Description != "" {
+		inLine.Description = &vastCData{Value: content.MetaData.Description}
+	}
+	for _, url := range getMonitorUrls(content, vastErrorEventType) {
+		inLine.Error = append(inLine.Error, vastCData{Value: url})
+	}
+	for _, url := range getMonitorUrls(content, impEventType) {
+		inLine.Impression = append(inLine.Impression, vastCData{Value: url})
+	}
+	// an InLine needs at least one Impression
+	if len(inLine.Impression) == 0 {
+		return "", errors.New("generate " + rootName + " adm failed: content has no imp monitor url, content id: " + content.Contentid)
+	}
+
+	var linear = vastLinear{
+		Duration:   getVastDuration(content),
+		MediaFiles: []vastMediaFile{mediaFile},
+	}
+	for _, monitor := range content.Monitor {
+		for _, event := range vastTrackingEvents[monitor.EventType] {
+			for _, url := range monitor.Url {
+				linear.TrackingEvents = append(linear.TrackingEvents, vastTracking{Event: event, Url: url})
+			}
+		}
+	}
+	var videoClick vastVideoClick
+	if clickThroughUrl := getClickThroughUrl(content); clickThroughUrl != "" {
+		videoClick.ClickThrough = &vastCData{Value: clickThroughUrl}
+	}
+	for _, url := range getMonitorUrls(content, clickEventType) {
+		videoClick.ClickTracking = append(videoClick.ClickTracking, vastCData{Value: url})
+	}
+	if videoClick.ClickThrough != nil || len(videoClick.ClickTracking) > 0 {
+		if rootName == daastRootName {
+			linear.AudioInteractions = &videoClick
+		} else {
+			linear.VideoClicks = &videoClick
+		}
+	}
+
+	var creative = vastCreative{
+		ID:     content.Contentid,
+		Linear: linear,
+	}
+	// VAST 4 renames the attribute to adId and requires UniversalAdId
+	if rootName == vastRootName && version == vastVersion4 {
+		creative.AdId = content.Contentid
+		creative.UniversalAdId = &vastUniversalAdId{IdRegistry: "unknown", Value: content.Contentid}
+	} else {
+		creative.AdID = content.Contentid
+	}
+	inLine.Creatives = []vastCreative{creative}
+
+	result, err := xml.Marshal(vast{
+		XMLName: xml.Name{Local: rootName},
+		Version: version,
+		Ad: vastAd{
+			ID:     content.Contentid,
+			InLine: inLine,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	return xml.Header + string(result), nil
+}
+
+// getVastMediaFile: mediaFile first, then videoInfo
+func getVastMediaFile(content *content, defaultMime string) vastMediaFile {
+	var mediaFile = vastMediaFile{
+		Delivery: "progressive",
+		Type:     content.MetaData.MediaFile.Mime,
+		Width:    content.MetaData.MediaFile.Width,
+		Height:   content.MetaData.MediaFile.Height,
+		Url:      content.MetaData.MediaFile.Url,
+	}
+	var videoInfo = content.MetaData.VideoInfo
+	if mediaFile.Url == "" {
+		mediaFile.Url = videoInfo.VideoDownloadUrl
+	}
+	if mediaFile.Width == 0 || mediaFile.Height == 0 {
+		mediaFile.Width = int64(videoInfo.Width)
+		mediaFile.Height = int64(videoInfo.Height)
+	}
+	if mediaFile.Type == "" {
+		mediaFile.Type = defaultMime
+	}
+	return mediaFile
+}
+
+// getVastDuration: huaweiads duration is in milliseconds, VAST needs HH:MM:SS.mmm
+func getVastDuration(content *content) string {
+	var duration = int64(content.MetaData.VideoInfo.VideoDuration)
+	if duration == 0 {
+		duration = content.MetaData.Duration
+	}
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", duration/3600000, duration/60000%60, duration/1000%60, duration%1000)
+}
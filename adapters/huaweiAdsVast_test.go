@@ -0,0 +1,239 @@
+package adapters
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/prebid/openrtb/v17/adcom1"
+)
+
+// newVideoContent: a 30s video content with imp, click and quartile monitors
+func newVideoContent() *content {
+	return &content{
+		Contentid:    "58025103",
+		Creativetype: 9,
+		MetaData: metaData{
+			Title:    "Huawei roll",
+			ClickUrl: "https://ads.huawei.com/landing",
+			VideoInfo: videoInfo{
+				VideoDownloadUrl: "https://ads.huawei.com/roll.mp4",
+				VideoDuration:    30500,
+				Width:            1280,
+				Height:           720,
+			},
+		},
+		Monitor: []monitor{
+			{EventType: impEventType, Url: []string{"https://events.huawei.com/imp"}},
+			{EventType: clickEventType, Url: []string{"https://events.huawei.com/click"}},
+			{EventType: "firstQuartile", Url: []string{"https://events.huawei.com/q1"}},
+		},
+	}
+}
+
+func TestBuildVastXmlRequiresImpression(t *testing.T) {
+	content := newVideoContent()
+	content.Monitor = content.Monitor[1:]
+	if adm, err := getVastAdm(content, vastVersion3); err == nil {
+		t.Errorf("getVastAdm without imp monitor = %s, want an error", adm)
+	}
+	if adm, err := getAudioAdm(content, []adcom1.MediaCreativeSubtype{adcom1.CreativeDAAST10}); err == nil {
+		t.Errorf("getAudioAdm without imp monitor = %s, want an error", adm)
+	}
+}
+
+func TestBuildVastXmlAdIDAttribute(t *testing.T) {
+	tests := []struct {
+		name      string
+		build     func(*content) (string, error)
+		attribute string
+		absent    string
+	}{
+		{
+			name:      "VAST 3",
+			build:     func(content *content) (string, error) { return getVastAdm(content, vastVersion3) },
+			attribute: `AdID="58025103"`,
+			absent:    `adId=`,
+		},
+		{
+			name:      "VAST 4",
+			build:     func(content *content) (string, error) { return getVastAdm(content, vastVersion4) },
+			attribute: `adId="58025103"`,
+			absent:    `AdID=`,
+		},
+		{
+			name: "DAAST",
+			build: func(content *content) (string, error) {
+				return getAudioAdm(content, []adcom1.MediaCreativeSubtype{adcom1.CreativeDAAST10})
+			},
+			attribute: `AdID="58025103"`,
+			absent:    `adId=`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			adm, err := test.build(newVideoContent())
+			if err != nil {
+				t.Fatalf("build adm: %v", err)
+			}
+			if !strings.Contains(adm, test.attribute) || strings.Contains(adm, test.absent) {
+				t.Errorf("adm = %s, want %s and no %s", adm, test.attribute, test.absent)
+			}
+		})
+	}
+}
+
+// vastDocument: the parts of a VAST or DAAST adm checked by the tests
+type vastDocument struct {
+	XMLName xml.Name
+	Version string `xml:"version,attr"`
+	Ad      struct {
+		ID     string `xml:"id,attr"`
+		InLine struct {
+			AdSystem   string   `xml:"AdSystem"`
+			Impression []string `xml:"Impression"`
+			Creative   struct {
+				Linear struct {
+					Duration       string `xml:"Duration"`
+					TrackingEvents []struct {
+						Event string `xml:"event,attr"`
+					} `xml:"TrackingEvents>Tracking"`
+					VideoClicks       *struct{} `xml:"VideoClicks"`
+					AudioInteractions *struct{} `xml:"AudioInteractions"`
+					MediaFile         struct {
+						Type   string `xml:"type,attr"`
+						Width  int64  `xml:"width,attr"`
+						Height int64  `xml:"height,attr"`
+						Url    string `xml:",chardata"`
+					} `xml:"MediaFiles>MediaFile"`
+				} `xml:"Linear"`
+			} `xml:"Creatives>Creative"`
+		} `xml:"InLine"`
+	} `xml:"Ad"`
+}
+
+func parseVastDocument(t *testing.T, adm string) vastDocument {
+	t.Helper()
+	var document vastDocument
+	if err := xml.Unmarshal([]byte(adm), &document); err != nil {
+		t.Fatalf("adm is not xml: %v, %s", err, adm)
+	}
+	return document
+}
+
+func TestGetVastVersion(t *testing.T) {
+	tests := []struct {
+		name      string
+		protocols []adcom1.MediaCreativeSubtype
+		version   string
+	}{
+		{name: "no protocols", version: vastVersion3},
+		{name: "VAST 3", protocols: []adcom1.MediaCreativeSubtype{adcom1.CreativeVAST30}, version: vastVersion3},
+		{name: "VAST 3 and 4", protocols: []adcom1.MediaCreativeSubtype{adcom1.CreativeVAST40, adcom1.CreativeVAST30}, version: vastVersion3},
+		{
+			name:      "VAST 4 only",
+			protocols: []adcom1.MediaCreativeSubtype{adcom1.CreativeVAST40, adcom1.CreativeVAST42Wrapper},
+			version:   vastVersion4,
+		},
+		{name: "VAST 4.1 only", protocols: []adcom1.MediaCreativeSubtype{adcom1.CreativeVAST41}, version: vastVersion4},
+		{name: "VAST 2 only", protocols: []adcom1.MediaCreativeSubtype{adcom1.CreativeVAST20}, version: vastVersion3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if version := getVastVersion(test.protocols); version != test.version {
+				t.Errorf("getVastVersion = %s, want %s", version, test.version)
+			}
+		})
+	}
+}
+
+func TestGetVastAdm(t *testing.T) {
+	for _, version := range []string{vastVersion3, vastVersion4} {
+		t.Run(version, func(t *testing.T) {
+			adm, err := getVastAdm(newVideoContent(), version)
+			if err != nil {
+				t.Fatalf("getVastAdm: %v", err)
+			}
+			document := parseVastDocument(t, adm)
+			if document.XMLName.Local != vastRootName || document.Version != version {
+				t.Errorf("root %s version %s, want %s %s", document.XMLName.Local, document.Version, vastRootName, version)
+			}
+			inLine := document.Ad.InLine
+			if document.Ad.ID != "58025103" || inLine.AdSystem != vastAdSystem || len(inLine.Impression) != 1 {
+				t.Errorf("ad %s, ad system %s, impressions %v", document.Ad.ID, inLine.AdSystem, inLine.Impression)
+			}
+			linear := inLine.Creative.Linear
+			if linear.Duration != "00:00:30.500" {
+				t.Errorf("Duration = %s, want 00:00:30.500", linear.Duration)
+			}
+			if len(linear.TrackingEvents) != 1 || linear.TrackingEvents[0].Event != "firstQuartile" {
+				t.Errorf("TrackingEvents = %v, want firstQuartile", linear.TrackingEvents)
+			}
+			if linear.VideoClicks == nil || linear.AudioInteractions != nil {
+				t.Error("want VideoClicks and no AudioInteractions")
+			}
+			mediaFile := linear.MediaFile
+			if mediaFile.Type != defaultVideoMime || mediaFile.Width != 1280 || mediaFile.Height != 720 ||
+				mediaFile.Url != "https://ads.huawei.com/roll.mp4" {
+				t.Errorf("MediaFile = %+v", mediaFile)
+			}
+		})
+	}
+
+	content := newVideoContent()
+	content.MetaData.VideoInfo.VideoDownloadUrl = ""
+	if adm, err := getVastAdm(content, vastVersion3); err == nil {
+		t.Errorf("getVastAdm without video url = %s, want an error", adm)
+	}
+}
+
+func TestGetAudioAdm(t *testing.T) {
+	tests := []struct {
+		name      string
+		protocols []adcom1.MediaCreativeSubtype
+		root      string
+		version   string
+	}{
+		{name: "no protocols", root: vastRootName, version: vastVersion3},
+		{
+			name:      "DAAST only",
+			protocols: []adcom1.MediaCreativeSubtype{adcom1.CreativeDAAST10, adcom1.CreativeDAAST10Wrapper},
+			root:      daastRootName,
+			version:   daastVersion1,
+		},
+		{
+			name:      "DAAST and VAST 4",
+			protocols: []adcom1.MediaCreativeSubtype{adcom1.CreativeDAAST10, adcom1.CreativeVAST40},
+			root:      vastRootName,
+			version:   vastVersion4,
+		},
+		{name: "VAST 3", protocols: []adcom1.MediaCreativeSubtype{adcom1.CreativeVAST30}, root: vastRootName, version: vastVersion3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			content := newVideoContent()
+			content.MetaData.VideoInfo = videoInfo{}
+			content.MetaData.Duration = 15000
+			content.MetaData.MediaFile = mediaFile{Url: "https://ads.huawei.com/audio.mp3"}
+			adm, err := getAudioAdm(content, test.protocols)
+			if err != nil {
+				t.Fatalf("getAudioAdm: %v", err)
+			}
+			document := parseVastDocument(t, adm)
+			if document.XMLName.Local != test.root || document.Version != test.version {
+				t.Errorf("root %s version %s, want %s %s", document.XMLName.Local, document.Version, test.root, test.version)
+			}
+			linear := document.Ad.InLine.Creative.Linear
+			if linear.MediaFile.Type != defaultAudioMime || linear.Duration != "00:00:15.000" {
+				t.Errorf("MediaFile type %s, Duration %s", linear.MediaFile.Type, linear.Duration)
+			}
+			if (test.root == daastRootName) != (linear.AudioInteractions != nil) {
+				t.Errorf("AudioInteractions %v for root %s", linear.AudioInteractions, test.root)
+			}
+		})
+	}
+
+	if adm, err := getAudioAdm(&content{Contentid: "c1"}, nil); err == nil {
+		t.Errorf("getAudioAdm without audio url = %s, want an error", adm)
+	}
+}
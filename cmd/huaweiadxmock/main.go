@@ -0,0 +1,30 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+
+	"main.go/mockserver"
+)
+
+// run the adapter against the mock from the repository root:
+//
+//	go run ./cmd/huaweiadxmock -fixtures cmd/huaweiadxmock/fixtures.json
+//	go run . -config cmd/huaweiadxmock/config.json
+//
+// the fixtures price contents in CNY, config.json points the adapter at the mock and at rates.json so that
+// USD requests get bids. Its huaweiVendorId is only a test value, a production config must use the IAB global
+// vendor list id of huaweiads
+func main() {
+	addr := flag.String("addr", ":8082", "listen address")
+	fixturesFile := flag.String("fixtures", "fixtures.json", "canned responses and accepted credentials, JSON file")
+	flag.Parse()
+
+	fixtures, err := mockserver.LoadFixtures(*fixturesFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Println("mock HuaweiAds ADX listening on " + *addr + mockserver.GetResultPath)
+	log.Fatal(http.ListenAndServe(*addr, mockserver.NewServer(fixtures)))
+}
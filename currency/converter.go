@@ -0,0 +1,140 @@
+// Package currency converts prices with the rates of a local rates file, the file can be reloaded at runtime.
+package currency
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Rates: the rates file, conversions[from][to] is the rate from one currency to another
+type Rates struct {
+	DataAsOf    string                        `json:"dataAsOf,omitempty"`
+	Conversions map[string]map[string]float64 `json:"conversions"`
+}
+
+type Converter struct {
+	path  string
+	mu    sync.RWMutex
+	rates Rates
+	// the base currencies of rates sorted, the order in which intermediate currencies are tried
+	intermediates []string
+}
+
+// NewConverter: load the rates file, the converter can't be used without rates
+func NewConverter(path string) (*Converter, error) {
+	converter := &Converter{path: path}
+	if err := converter.Reload(); err != nil {
+		return nil, err
+	}
+	return converter, nil
+}
+
+// Reload: read the rates file again, the current rates are kept when it fails
+func (c *Converter) Reload() error {
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return err
+	}
+	var rates Rates
+	if err := json.Unmarshal(data, &rates); err != nil {
+		return errors.New("invalid currency rates file " + c.path + ": " + err.Error())
+	}
+	var conversions = make(map[string]map[string]float64, len(rates.Conversions))
+	for from, toRates := range rates.Conversions {
+		var upperToRates = make(map[string]float64, len(toRates))
+		for to, rate := range toRates {
+			if rate <= 0 {
+				return errors.New("invalid currency rate from " + from + " to " + to + " in " + c.path)
+			}
+			upperToRates[strings.ToUpper(to)] = rate
+		}
+		conversions[strings.ToUpper(from)] = upperToRates
+	}
+	rates.Conversions = conversions
+	var intermediates = make([]string, 0, len(conversions))
+	for from := range conversions {
+		intermediates = append(intermediates, from)
+	}
+	sort.Strings(intermediates)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.rates = rates
+	c.intermediates = intermediates
+	return nil
+}
+
+// StartRefresh: reload the rates file every interval until stop is called, onError gets the reload errors
+func (c *Converter) StartRefresh(interval time.Duration, onError func(error)) (stop func()) {
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				if err := c.Reload(); err != nil && onError != nil {
+					onError(err)
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			ticker.Stop()
+			close(done)
+		})
+	}
+}
+
+// Convert: convert amount from one currency to another
+func (c *Converter) Convert(amount float64, from string, to string) (float64, error) {
+	rate, err := c.GetRate(from, to)
+	if err != nil {
+		return 0, err
+	}
+	return amount * rate, nil
+}
+
+// GetRate: direct rate first, then the inverse rate, then through one intermediate currency. The base currencies of
+// the rates file are tried as intermediates in alphabetical order, so a conversion always uses the same cross rate
+func (c *Converter) GetRate(from string, to string) (float64, error) {
+	from = strings.ToUpper(from)
+	to = strings.ToUpper(to)
+	if from == to {
+		return 1, nil
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if rate, found := c.lookup(from, to); found {
+		return rate, nil
+	}
+	for _, intermediate := range c.intermediates {
+		fromRate, found := c.lookup(from, intermediate)
+		if !found {
+			continue
+		}
+		if toRate, found := c.lookup(intermediate, to); found {
+			return fromRate * toRate, nil
+		}
+	}
+	return 0, errors.New("currency conversion rate from " + from + " to " + to + " not found")
+}
+
+func (c *Converter) lookup(from string, to string) (float64, bool) {
+	if rate, found := c.rates.Conversions[from][to]; found {
+		return rate, true
+	}
+	if rate, found := c.rates.Conversions[to][from]; found {
+		return 1 / rate, true
+	}
+	return 0, false
+}
@@ -0,0 +1,217 @@
+package currency_test
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"main.go/currency"
+)
+
+// writeRates: write a rates file in a test directory and return its path
+func writeRates(t *testing.T, rates string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "rates.json")
+	if err := os.WriteFile(path, []byte(rates), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func newConverter(t *testing.T, rates string) *currency.Converter {
+	t.Helper()
+	converter, err := currency.NewConverter(writeRates(t, rates))
+	if err != nil {
+		t.Fatalf("NewConverter: %v", err)
+	}
+	return converter
+}
+
+func TestGetRateCrossRateIsStable(t *testing.T) {
+	// CNY to JPY can go through EUR or USD and the two cross rates differ, EUR comes first alphabetically
+	converter := newConverter(t, `{"conversions": {
+		"USD": {"CNY": 7, "JPY": 150},
+		"EUR": {"CNY": 8, "JPY": 160}
+	}}`)
+	want := 160.0 / 8.0
+	for i := 0; i < 100; i++ {
+		rate, err := converter.GetRate("CNY", "JPY")
+		if err != nil {
+			t.Fatalf("GetRate: %v", err)
+		}
+		if math.Abs(rate-want) > 1e-9 {
+			t.Fatalf("GetRate(CNY, JPY) = %v on call %d, want %v through EUR", rate, i, want)
+		}
+	}
+}
+
+func TestGetRate(t *testing.T) {
+	converter := newConverter(t, `{"dataAsOf": "2026-10-01", "conversions": {
+		"USD": {"CNY": 7.1, "eur": 0.92},
+		"cny": {"RUB": 12.5}
+	}}`)
+	tests := []struct {
+		name string
+		from string
+		to   string
+		rate float64
+		err  bool
+	}{
+		{name: "same currency", from: "XXX", to: "xxx", rate: 1},
+		{name: "direct", from: "USD", to: "CNY", rate: 7.1},
+		{name: "lower case codes", from: "usd", to: "eur", rate: 0.92},
+		{name: "inverse", from: "CNY", to: "USD", rate: 1 / 7.1},
+		{name: "lower case base", from: "CNY", to: "RUB", rate: 12.5},
+		{name: "cross through a base", from: "EUR", to: "CNY", rate: 7.1 / 0.92},
+		{name: "cross through an inverse", from: "USD", to: "RUB", rate: 7.1 * 12.5},
+		{name: "only one intermediate", from: "RUB", to: "EUR", err: true},
+		{name: "unknown currency", from: "USD", to: "JPY", err: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rate, err := converter.GetRate(test.from, test.to)
+			if test.err {
+				if err == nil {
+					t.Fatalf("GetRate(%s, %s) = %v, want an error", test.from, test.to, rate)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetRate(%s, %s): %v", test.from, test.to, err)
+			}
+			if math.Abs(rate-test.rate) > 1e-9 {
+				t.Errorf("GetRate(%s, %s) = %v, want %v", test.from, test.to, rate, test.rate)
+			}
+		})
+	}
+}
+
+func TestConvert(t *testing.T) {
+	converter := newConverter(t, `{"conversions": {"USD": {"CNY": 7.1}}}`)
+	amount, err := converter.Convert(14.2, "CNY", "USD")
+	if err != nil {
+		t.Fatalf("Convert: %v", err)
+	}
+	if math.Abs(amount-2) > 1e-9 {
+		t.Errorf("Convert(14.2, CNY, USD) = %v, want 2", amount)
+	}
+	if amount, err := converter.Convert(1, "USD", "JPY"); err == nil {
+		t.Errorf("Convert(1, USD, JPY) = %v, want an error", amount)
+	}
+}
+
+func TestReload(t *testing.T) {
+	path := writeRates(t, `{"conversions": {"USD": {"CNY": 7.1}}}`)
+	converter, err := currency.NewConverter(path)
+	if err != nil {
+		t.Fatalf("NewConverter: %v", err)
+	}
+
+	// new rates are used after a reload
+	if err := os.WriteFile(path, []byte(`{"conversions": {"USD": {"CNY": 7.2, "EUR": 0.9}}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := converter.Reload(); err != nil {
+		t.Fatalf("Reload: %v", err)
+	}
+	checkRate(t, converter, "USD", "CNY", 7.2)
+	checkRate(t, converter, "USD", "EUR", 0.9)
+
+	// a failed reload keeps the previous rates
+	for _, rates := range []string{`{"conversions": {"USD": {"CNY": 0}}}`, `{"conversions": `} {
+		if err := os.WriteFile(path, []byte(rates), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := converter.Reload(); err == nil {
+			t.Errorf("Reload of %s succeeded, want an error", rates)
+		}
+		checkRate(t, converter, "USD", "CNY", 7.2)
+	}
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	if err := converter.Reload(); err == nil {
+		t.Error("Reload of a missing file succeeded, want an error")
+	}
+	checkRate(t, converter, "USD", "EUR", 0.9)
+}
+
+func TestStartRefresh(t *testing.T) {
+	path := writeRates(t, `{"conversions": {"USD": {"CNY": 7.1}}}`)
+	converter, err := currency.NewConverter(path)
+	if err != nil {
+		t.Fatalf("NewConverter: %v", err)
+	}
+	reloadErrors := make(chan error, 1)
+	stop := converter.StartRefresh(10*time.Millisecond, func(err error) {
+		select {
+		case reloadErrors <- err:
+		default:
+		}
+	})
+	defer stop()
+
+	if err := os.WriteFile(path, []byte(`{"conversions": {"USD": {"CNY": 7.3}}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		rate, err := converter.GetRate("USD", "CNY")
+		if err == nil && rate == 7.3 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("GetRate(USD, CNY) = %v, %v after the refresh, want 7.3", rate, err)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	// a reload can read the file while it is written, drop that error
+	select {
+	case <-reloadErrors:
+	default:
+	}
+	if err := os.WriteFile(path, []byte(`{"conversions": `), 0644); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-reloadErrors:
+	case <-time.After(2 * time.Second):
+		t.Fatal("onError was not called for an invalid rates file")
+	}
+	checkRate(t, converter, "USD", "CNY", 7.3)
+}
+
+func TestNewConverterErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		rates string
+	}{
+		{name: "not json", rates: `USD,CNY,7.1`},
+		{name: "zero rate", rates: `{"conversions": {"USD": {"CNY": 0}}}`},
+		{name: "negative rate", rates: `{"conversions": {"USD": {"CNY": -7.1}}}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := currency.NewConverter(writeRates(t, test.rates)); err == nil {
+				t.Errorf("NewConverter succeeded, want an error")
+			}
+		})
+	}
+	if _, err := currency.NewConverter(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Errorf("NewConverter of a missing file succeeded, want an error")
+	}
+}
+
+func checkRate(t *testing.T, converter *currency.Converter, from string, to string, want float64) {
+	t.Helper()
+	rate, err := converter.GetRate(from, to)
+	if err != nil {
+		t.Fatalf("GetRate(%s, %s): %v", from, to, err)
+	}
+	if math.Abs(rate-want) > 1e-9 {
+		t.Errorf("GetRate(%s, %s) = %v, want %v", from, to, rate, want)
+	}
+}
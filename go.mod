@@ -2,4 +2,9 @@ module main.go
 
 go 1.19
 
-require github.com/prebid/openrtb/v17 v17.1.0
\ No newline at end of file
+require (
+	github.com/oschwald/maxminddb-golang v1.12.0
+	github.com/prebid/openrtb/v17 v17.1.0
+)
+
+require golang.org/x/sys v0.10.0 // indirect
@@ -0,0 +1,158 @@
+// Package gpp splits an IAB Global Privacy Platform string into its sections.
+package gpp
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// section ids of the GPP section registry
+const (
+	SectionTCFEUv2 = 2
+	SectionUSPV1   = 6
+	SectionUSNat   = 7
+)
+
+const headerType = 3
+
+// the section registry has ids well below maxSectionID, anything above comes from a corrupt or malicious header
+const maxSectionID = 500
+
+// GPP: the section ids of the header and their encoded sections
+type GPP struct {
+	Version    int
+	SectionIDs []int
+	Sections   map[int]string
+}
+
+// Parse: the header is the first '~' separated part, one section follows per header section id
+func Parse(gppString string) (*GPP, error) {
+	parts := strings.Split(gppString, "~")
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[0], "="))
+	if err != nil {
+		return nil, errors.New("GPP header is not base64url: " + err.Error())
+	}
+	reader := &bitReader{data: data}
+	typ, err := reader.readInt(6)
+	if err != nil {
+		return nil, err
+	}
+	if typ != headerType {
+		return nil, errors.New("GPP header type " + strconv.Itoa(typ) + " is not " + strconv.Itoa(headerType))
+	}
+	var result = GPP{Sections: make(map[int]string)}
+	if result.Version, err = reader.readInt(6); err != nil {
+		return nil, err
+	}
+	if result.SectionIDs, err = reader.readFibonacciRange(); err != nil {
+		return nil, errors.New("invalid GPP header section ids: " + err.Error())
+	}
+	if len(result.SectionIDs) != len(parts)-1 {
+		return nil, errors.New("GPP header has " + strconv.Itoa(len(result.SectionIDs)) + " section ids but the string has " +
+			strconv.Itoa(len(parts)-1) + " sections")
+	}
+	for i, sectionID := range result.SectionIDs {
+		result.Sections[sectionID] = parts[i+1]
+	}
+	return &result, nil
+}
+
+type bitReader struct {
+	data   []byte
+	offset int
+}
+
+func (r *bitReader) readBool() (bool, error) {
+	if r.offset/8 >= len(r.data) {
+		return false, errors.New("GPP data is too short")
+	}
+	bit := r.data[r.offset/8]&(0x80>>(r.offset%8)) != 0
+	r.offset++
+	return bit, nil
+}
+
+func (r *bitReader) readInt(bits int) (int, error) {
+	var value = 0
+	for i := 0; i < bits; i++ {
+		bit, err := r.readBool()
+		if err != nil {
+			return 0, err
+		}
+		value <<= 1
+		if bit {
+			value |= 1
+		}
+	}
+	return value, nil
+}
+
+// readFibonacciInt: Fibonacci coded integer, 1, 2, 3, 5, 8... terminated by two consecutive 1 bits. Values above
+// maxSectionID are rejected, which also keeps a long run of bits from overflowing
+func (r *bitReader) readFibonacciInt() (int, error) {
+	var value = 0
+	var fib, nextFib = 1, 2
+	var lastBit = false
+	for {
+		bit, err := r.readBool()
+		if err != nil {
+			return 0, err
+		}
+		if bit && lastBit {
+			return value, nil
+		}
+		if bit {
+			if fib > maxSectionID {
+				return 0, errors.New("fibonacci value above " + strconv.Itoa(maxSectionID))
+			}
+			value += fib
+		}
+		lastBit = bit
+		if fib <= maxSectionID {
+			fib, nextFib = nextFib, fib+nextFib
+		}
+	}
+}
+
+// readFibonacciRange: 12 bit count, then per entry IsRange 1 bit and Fibonacci coded offsets from the previous id,
+// a range has the offset of its start and its length. The offsets are at least 1, so the ids are ascending and a
+// header can't expand into more than maxSectionID ids
+func (r *bitReader) readFibonacciRange() ([]int, error) {
+	count, err := r.readInt(12)
+	if err != nil {
+		return nil, err
+	}
+	var ids []int
+	var last = 0
+	for i := 0; i < count; i++ {
+		isRange, err := r.readBool()
+		if err != nil {
+			return nil, err
+		}
+		offset, err := r.readFibonacciInt()
+		if err != nil {
+			return nil, err
+		}
+		start := last + offset
+		end := start
+		if isRange {
+			length, err := r.readFibonacciInt()
+			if err != nil {
+				return nil, err
+			}
+			end = start + length
+		}
+		if end < start {
+			return nil, errors.New("section range " + strconv.Itoa(start) + "-" + strconv.Itoa(end) + " ends before it starts")
+		}
+		if end > maxSectionID {
+			return nil, errors.New("section id " + strconv.Itoa(end) + " is above " + strconv.Itoa(maxSectionID))
+		}
+		for id := start; id <= end; id++ {
+			ids = append(ids, id)
+		}
+		last = end
+	}
+	return ids, nil
+}
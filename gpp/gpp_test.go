@@ -0,0 +1,90 @@
+package gpp_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"main.go/gpp"
+)
+
+const tcfEuV2Section = "CPXxRfAPXxRfAAfKABENB-CgAAAAAAAAAAYgAAAAAAAA"
+
+// usnat version 1, every notice given, no opt-out, with a GPC subsection
+const usNatSection = "BVVqAAEABCA.QA"
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name       string
+		gpp        string
+		sectionIDs []int
+		sections   map[int]string
+	}{
+		{
+			name:       "uspv1",
+			gpp:        "DBABTA~1YNN",
+			sectionIDs: []int{gpp.SectionUSPV1},
+			sections:   map[int]string{gpp.SectionUSPV1: "1YNN"},
+		},
+		{
+			name:       "tcfeuv2 and uspv1",
+			gpp:        "DBACNYA~" + tcfEuV2Section + "~1YNN",
+			sectionIDs: []int{gpp.SectionTCFEUv2, gpp.SectionUSPV1},
+			sections:   map[int]string{gpp.SectionTCFEUv2: tcfEuV2Section, gpp.SectionUSPV1: "1YNN"},
+		},
+		{
+			name:       "usnat",
+			gpp:        "DBABLA~" + usNatSection,
+			sectionIDs: []int{gpp.SectionUSNat},
+			sections:   map[int]string{gpp.SectionUSNat: usNatSection},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			parsed, err := gpp.Parse(test.gpp)
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			if parsed.Version != 1 {
+				t.Errorf("Version = %d, want 1", parsed.Version)
+			}
+			if !reflect.DeepEqual(parsed.SectionIDs, test.sectionIDs) {
+				t.Errorf("SectionIDs = %v, want %v", parsed.SectionIDs, test.sectionIDs)
+			}
+			if !reflect.DeepEqual(parsed.Sections, test.sections) {
+				t.Errorf("Sections = %v, want %v", parsed.Sections, test.sections)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		gpp  string
+		err  string
+	}{
+		{name: "empty", gpp: "", err: "too short"},
+		{name: "not base64url", gpp: "DB*BTA~1YNN", err: "not base64url"},
+		{name: "not a header", gpp: "BVVqAAEABCA~1YNN", err: "header type"},
+		{name: "missing section", gpp: "DBACNYA~1YNN", err: "2 section ids but the string has 1 sections"},
+		{name: "extra section", gpp: "DBABTA~1YNN~1YNN", err: "1 section ids but the string has 2 sections"},
+		{name: "truncated section ids", gpp: "DBAB", err: "invalid GPP header section ids"},
+		// a range of about 1e9 ids, it must be rejected before the ids are expanded
+		{name: "huge section range", gpp: "DBAB9VVVVVVY~x", err: "above 500"},
+		{name: "long fibonacci code", gpp: "DBABAAAAAAAAAAAAAAAABg~x", err: "above 500"},
+		{name: "section id above the limit", gpp: "DBABEVY~x", err: "above 500"},
+		{name: "section range above the limit", gpp: "DBABkSas~x", err: "section id 510 is above 500"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			parsed, err := gpp.Parse(test.gpp)
+			if err == nil {
+				t.Fatalf("Parse returned %+v, want an error", parsed)
+			}
+			if !strings.Contains(err.Error(), test.err) {
+				t.Errorf("error = %q, want it to contain %q", err, test.err)
+			}
+		})
+	}
+}
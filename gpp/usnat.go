@@ -0,0 +1,56 @@
+package gpp
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// values of the usnat notice and opt-out fields
+const (
+	USNatNotApplicable = 0
+	USNatOptedOut      = 1
+	USNatDidNotOptOut  = 2
+)
+
+// bit offsets of the usnat core segment fields, the same in version 1 and 2
+const (
+	usNatVersionOffset    = 0
+	usNatSaleNoticeOffset = 8
+	usNatSaleOptOutOffset = 18
+)
+
+// USNat: the sale fields of the usnat section (section id 7), the sharing, targeted advertising and sensitive
+// data fields are not decoded
+type USNat struct {
+	Version          int
+	SaleOptOutNotice int
+	SaleOptOut       int
+}
+
+// ParseUSNat: decode the core segment of a usnat section, the GPC subsection after '.' is ignored
+func ParseUSNat(section string) (*USNat, error) {
+	core := strings.TrimRight(strings.Split(section, ".")[0], "=")
+	data, err := base64.RawURLEncoding.DecodeString(core)
+	if err != nil {
+		return nil, errors.New("usnat section is not base64url: " + err.Error())
+	}
+	var result USNat
+	reader := &bitReader{data: data, offset: usNatVersionOffset}
+	if result.Version, err = reader.readInt(6); err != nil {
+		return nil, err
+	}
+	if result.Version != 1 && result.Version != 2 {
+		return nil, errors.New("usnat section version " + strconv.Itoa(result.Version) + " is not supported")
+	}
+	reader.offset = usNatSaleNoticeOffset
+	if result.SaleOptOutNotice, err = reader.readInt(2); err != nil {
+		return nil, err
+	}
+	reader.offset = usNatSaleOptOutOffset
+	if result.SaleOptOut, err = reader.readInt(2); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,66 @@
+package gpp_test
+
+import (
+	"strings"
+	"testing"
+
+	"main.go/gpp"
+)
+
+func TestParseUSNat(t *testing.T) {
+	tests := []struct {
+		name    string
+		section string
+		want    gpp.USNat
+	}{
+		{
+			name:    "did not opt out",
+			section: usNatSection,
+			want:    gpp.USNat{Version: 1, SaleOptOutNotice: 1, SaleOptOut: gpp.USNatDidNotOptOut},
+		},
+		{
+			name:    "opted out of sale",
+			section: "BVVaAAEABCA",
+			want:    gpp.USNat{Version: 1, SaleOptOutNotice: 1, SaleOptOut: gpp.USNatOptedOut},
+		},
+		{
+			name:    "not applicable",
+			section: "BAAAAAAAAAA",
+			want:    gpp.USNat{Version: 1, SaleOptOutNotice: gpp.USNatNotApplicable, SaleOptOut: gpp.USNatNotApplicable},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			usNat, err := gpp.ParseUSNat(test.section)
+			if err != nil {
+				t.Fatalf("ParseUSNat: %v", err)
+			}
+			if *usNat != test.want {
+				t.Errorf("ParseUSNat = %+v, want %+v", *usNat, test.want)
+			}
+		})
+	}
+}
+
+func TestParseUSNatErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		section string
+		err     string
+	}{
+		{name: "not base64url", section: "BV*q", err: "not base64url"},
+		{name: "version 3", section: "DVVqAAEABCA", err: "version 3 is not supported"},
+		{name: "truncated", section: "BV", err: "too short"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			usNat, err := gpp.ParseUSNat(test.section)
+			if err == nil {
+				t.Fatalf("ParseUSNat returned %+v, want an error", usNat)
+			}
+			if !strings.Contains(err.Error(), test.err) {
+				t.Errorf("error = %q, want it to contain %q", err, test.err)
+			}
+		})
+	}
+}
@@ -1,13 +1,17 @@
 package main
 
 import (
+	"flag"
 	"log"
 	"net/http"
+	"os"
 	"encoding/json"
 	openrtb2 "github.com/prebid/openrtb/v17/openrtb2"
 	adapters "main.go/adapters"
 )
 
+var bidder adapters.Bidder
+
 func home(w http.ResponseWriter, req *http.Request) {
 	decoder := json.NewDecoder(req.Body)
 	var bidRequest openrtb2.BidRequest
@@ -15,22 +19,51 @@ func home(w http.ResponseWriter, req *http.Request) {
 	if err != nil {
 		panic(err)
 	}
-	huaweiAdsRequest, _ := adapters.MakeRequest(&bidRequest)
+	bidResponse, errs := bidder.RequestBids(req.Context(), &bidRequest)
+	for _, err := range errs {
+		log.Println(err)
+	}
+	if bidResponse == nil {
+		message := "no bid response"
+		if len(errs) > 0 {
+			message = errs[0].Error()
+		}
+		http.Error(w, message, http.StatusBadGateway)
+		return
+	}
 	w.Header().Set("Content-Type", "application/json")
-    w.WriteHeader(http.StatusCreated)
-    json.NewEncoder(w).Encode(huaweiAdsRequest)
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(bidResponse)
 }
 
-// func makeRequest(openRTBRequest *openrtb2.BidRequest) {
-// 	huaweiAdsRequest, _ := adapters.MakeRequest(openRTBRequest)
-// 	fmt.Printf("%+v\n", huaweiAdsRequest)
-// }
+// loadConfig: empty path means the default production config
+func loadConfig(path string) (adapters.Config, error) {
+	var config adapters.Config
+	if path == "" {
+		return config, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return config, err
+	}
+	err = json.Unmarshal(data, &config)
+	return config, err
+}
 
 func handleRequests() {
 	http.HandleFunc("/",home)
 }
 
 func main() {
+	configFile := flag.String("config", "", "HuaweiAds adapter config JSON file, endpoints, extraInfo and huaweiVendorId")
+	flag.Parse()
+	config, err := loadConfig(*configFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if bidder, err = adapters.Builder(config); err != nil {
+		log.Fatal(err)
+	}
 	handleRequests()
 	log.Fatal(http.ListenAndServe(":8081", nil))
 }
\ No newline at end of file
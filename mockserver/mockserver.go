@@ -0,0 +1,185 @@
+// Package mockserver is a stand-in for the HuaweiAds ADX getResult api, used for offline integration testing.
+package mockserver
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+)
+
+const GetResultPath = "/ppsadx/getResult"
+const realm = "ppsadx/getResult"
+
+// Credential: publisher whose requests are accepted
+type Credential struct {
+	PublisherId string `json:"publisherid"`
+	SignKey     string `json:"signkey"`
+	KeyId       string `json:"keyid"`
+}
+
+// SlotFixture: the canned ad30 returned for a slotid and adtype
+type SlotFixture struct {
+	Slotid    string          `json:"slotid"`
+	Adtype    int32           `json:"adtype"`
+	Retcode30 int32           `json:"retcode30,omitempty"`
+	Content   json.RawMessage `json:"content,omitempty"`
+}
+
+type Fixtures struct {
+	Credentials []Credential  `json:"credentials"`
+	Slots       []SlotFixture `json:"slots"`
+}
+
+type huaweiAdsRequest struct {
+	Multislot []struct {
+		Slotid string `json:"slotid"`
+		Adtype int32  `json:"adtype"`
+	} `json:"multislot"`
+}
+
+type huaweiAdsResponse struct {
+	Retcode int32  `json:"retcode"`
+	Reason  string `json:"reason,omitempty"`
+	Multiad []ad30 `json:"multiad,omitempty"`
+}
+
+type ad30 struct {
+	AdType    int32           `json:"adtype"`
+	Slotid    string          `json:"slotid"`
+	Retcode30 int32           `json:"retcode30"`
+	Content   json.RawMessage `json:"content,omitempty"`
+}
+
+type slotKey struct {
+	slotid string
+	adtype int32
+}
+
+// Server: http.Handler of the mock HuaweiAds ADX, fixtures can be replaced while serving
+type Server struct {
+	mu          sync.RWMutex
+	credentials map[string]Credential
+	slots       map[slotKey]SlotFixture
+}
+
+func NewServer(fixtures Fixtures) *Server {
+	server := &Server{}
+	server.SetFixtures(fixtures)
+	return server
+}
+
+// NewTestServer: start the mock on a local port for tests, the caller closes it
+func NewTestServer(fixtures Fixtures) *httptest.Server {
+	return httptest.NewServer(NewServer(fixtures))
+}
+
+// LoadFixtures: read fixtures from a JSON file
+func LoadFixtures(path string) (Fixtures, error) {
+	var fixtures Fixtures
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fixtures, err
+	}
+	if err := json.Unmarshal(data, &fixtures); err != nil {
+		return fixtures, errors.New("invalid fixtures " + path + ": " + err.Error())
+	}
+	return fixtures, nil
+}
+
+func (s *Server) SetFixtures(fixtures Fixtures) {
+	credentials := make(map[string]Credential, len(fixtures.Credentials))
+	for _, credential := range fixtures.Credentials {
+		credentials[credential.PublisherId+":"+credential.KeyId] = credential
+	}
+	slots := make(map[slotKey]SlotFixture, len(fixtures.Slots))
+	for _, slot := range fixtures.Slots {
+		slots[slotKey{slot.Slotid, slot.Adtype}] = slot
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.credentials = credentials
+	s.slots = slots
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path != GetResultPath {
+		http.NotFound(w, req)
+		return
+	}
+	if req.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if err := s.verifyAuthorization(req.Header.Get("Authorization")); err != nil {
+		writeResponse(w, huaweiAdsResponse{Retcode: 401, Reason: err.Error()})
+		return
+	}
+
+	var request huaweiAdsRequest
+	if err := json.NewDecoder(req.Body).Decode(&request); err != nil || len(request.Multislot) == 0 {
+		writeResponse(w, huaweiAdsResponse{Retcode: 400, Reason: "invalid request body"})
+		return
+	}
+
+	var response = huaweiAdsResponse{Retcode: 204}
+	for _, slot := range request.Multislot {
+		var ad = ad30{AdType: slot.Adtype, Slotid: slot.Slotid, Retcode30: 204}
+		if fixture, found := s.slots[slotKey{slot.Slotid, slot.Adtype}]; found {
+			ad.Content = fixture.Content
+			ad.Retcode30 = fixture.Retcode30
+			if ad.Retcode30 == 0 {
+				ad.Retcode30 = 200
+			}
+		}
+		if ad.Retcode30 == 200 {
+			response.Retcode = 200
+		}
+		response.Multiad = append(response.Multiad, ad)
+	}
+	writeResponse(w, response)
+}
+
+// verifyAuthorization: recompute the HmacSHA256 digest the adapter signs with
+func (s *Server) verifyAuthorization(authorization string) error {
+	if !strings.HasPrefix(authorization, "Digest ") {
+		return errors.New("authorization is not a digest")
+	}
+	var params = make(map[string]string)
+	for _, param := range strings.Split(strings.TrimPrefix(authorization, "Digest "), ",") {
+		if kv := strings.SplitN(strings.TrimSpace(param), "=", 2); len(kv) == 2 {
+			params[kv[0]] = kv[1]
+		}
+	}
+	if params["realm"] != realm || params["algorithm"] != "HmacSHA256" {
+		return errors.New("unsupported digest realm or algorithm")
+	}
+	credential, found := s.credentials[params["username"]+":"+params["keyid"]]
+	if !found {
+		return errors.New("unknown publisherid or keyid")
+	}
+
+	h := hmac.New(sha256.New, []byte(credential.PublisherId+":"+realm+":"+credential.SignKey))
+	h.Write([]byte(params["nonce"] + ":POST:" + GetResultPath))
+	expected := hex.EncodeToString(h.Sum(nil))
+	if !hmac.Equal([]byte(expected), []byte(params["response"])) {
+		return errors.New("signature rejected")
+	}
+	return nil
+}
+
+func writeResponse(w http.ResponseWriter, response huaweiAdsResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,202 @@
+// Package tcf2 decodes the core segment of an IAB TCF v2 consent string.
+package tcf2
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// bit offsets of the core segment fields
+const (
+	versionOffset             = 0
+	purposesConsentOffset     = 152
+	purposesLIOffset          = 176
+	purposeOneTreatmentOffset = 200
+	vendorConsentOffset       = 213
+)
+
+const numPurposes = 24
+
+// Consent: the purposes and vendors of a TCF v2 core segment, purpose and vendor ids start at 1
+type Consent struct {
+	Version             int
+	PurposeOneTreatment bool
+	purposesConsent     uint32
+	purposesLI          uint32
+	vendorConsents      vendorSet
+	vendorLI            vendorSet
+}
+
+// Parse: decode the core segment, the other segments after '.' are ignored
+func Parse(consent string) (*Consent, error) {
+	if consent == "" {
+		return nil, errors.New("TCF v2 consent string is empty")
+	}
+	core := strings.TrimRight(strings.Split(consent, ".")[0], "=")
+	data, err := base64.RawURLEncoding.DecodeString(core)
+	if err != nil {
+		return nil, errors.New("TCF v2 consent string is not base64url: " + err.Error())
+	}
+	reader := &bitReader{data: data}
+
+	var result Consent
+	if result.Version, err = reader.readInt(versionOffset, 6); err != nil {
+		return nil, err
+	}
+	if result.Version != 2 {
+		return nil, errors.New("TCF consent string version " + strconv.Itoa(result.Version) + " is not supported")
+	}
+	purposesConsent, err := reader.readInt(purposesConsentOffset, numPurposes)
+	if err != nil {
+		return nil, err
+	}
+	result.purposesConsent = uint32(purposesConsent)
+	purposesLI, err := reader.readInt(purposesLIOffset, numPurposes)
+	if err != nil {
+		return nil, err
+	}
+	result.purposesLI = uint32(purposesLI)
+	if result.PurposeOneTreatment, err = reader.readBool(purposeOneTreatmentOffset); err != nil {
+		return nil, err
+	}
+
+	var offset = vendorConsentOffset
+	if result.vendorConsents, offset, err = reader.readVendorSet(offset); err != nil {
+		return nil, errors.New("invalid TCF v2 vendor consent section: " + err.Error())
+	}
+	if result.vendorLI, _, err = reader.readVendorSet(offset); err != nil {
+		return nil, errors.New("invalid TCF v2 vendor legitimate interest section: " + err.Error())
+	}
+	return &result, nil
+}
+
+// PurposeConsent: the user consents to the purpose
+func (c *Consent) PurposeConsent(purpose int) bool {
+	return purposeBit(c.purposesConsent, purpose)
+}
+
+// PurposeLITransparency: the legal basis of legitimate interest was disclosed for the purpose
+func (c *Consent) PurposeLITransparency(purpose int) bool {
+	return purposeBit(c.purposesLI, purpose)
+}
+
+// VendorConsent: the user consents to the vendor
+func (c *Consent) VendorConsent(vendorID int) bool {
+	return c.vendorConsents.contains(vendorID)
+}
+
+// VendorLegitimateInterest: the legal basis of legitimate interest was disclosed for the vendor
+func (c *Consent) VendorLegitimateInterest(vendorID int) bool {
+	return c.vendorLI.contains(vendorID)
+}
+
+// purposeBit: purpose 1 is the highest bit of the 24 bit field
+func purposeBit(field uint32, purpose int) bool {
+	if purpose < 1 || purpose > numPurposes {
+		return false
+	}
+	return field&(1<<(numPurposes-purpose)) != 0
+}
+
+// vendorSet: bit field or ranges, the same vendor section encodings of the consent string
+type vendorSet struct {
+	maxVendorID int
+	bitField    []bool
+	ranges      [][2]int
+}
+
+func (s vendorSet) contains(vendorID int) bool {
+	if vendorID < 1 || vendorID > s.maxVendorID {
+		return false
+	}
+	if s.bitField != nil {
+		return s.bitField[vendorID-1]
+	}
+	for _, r := range s.ranges {
+		if vendorID >= r[0] && vendorID <= r[1] {
+			return true
+		}
+	}
+	return false
+}
+
+type bitReader struct {
+	data []byte
+}
+
+func (r *bitReader) readBool(offset int) (bool, error) {
+	if offset/8 >= len(r.data) {
+		return false, errors.New("TCF v2 consent string is too short")
+	}
+	return r.data[offset/8]&(0x80>>(offset%8)) != 0, nil
+}
+
+func (r *bitReader) readInt(offset int, bits int) (int, error) {
+	var value = 0
+	for i := 0; i < bits; i++ {
+		bit, err := r.readBool(offset + i)
+		if err != nil {
+			return 0, err
+		}
+		value <<= 1
+		if bit {
+			value |= 1
+		}
+	}
+	return value, nil
+}
+
+// readVendorSet: MaxVendorId 16 bits, IsRangeEncoding 1 bit, then a bit field or NumEntries 12 bits and the
+// entries, IsARange 1 bit, StartOrOnlyVendorId 16 bits and EndVendorId 16 bits for ranges
+func (r *bitReader) readVendorSet(offset int) (vendorSet, int, error) {
+	var set vendorSet
+	var err error
+	if set.maxVendorID, err = r.readInt(offset, 16); err != nil {
+		return set, 0, err
+	}
+	isRangeEncoding, err := r.readBool(offset + 16)
+	if err != nil {
+		return set, 0, err
+	}
+	offset += 17
+	if !isRangeEncoding {
+		set.bitField = make([]bool, set.maxVendorID)
+		for i := range set.bitField {
+			if set.bitField[i], err = r.readBool(offset + i); err != nil {
+				return set, 0, err
+			}
+		}
+		return set, offset + set.maxVendorID, nil
+	}
+
+	numEntries, err := r.readInt(offset, 12)
+	if err != nil {
+		return set, 0, err
+	}
+	offset += 12
+	for i := 0; i < numEntries; i++ {
+		isARange, err := r.readBool(offset)
+		if err != nil {
+			return set, 0, err
+		}
+		start, err := r.readInt(offset+1, 16)
+		if err != nil {
+			return set, 0, err
+		}
+		offset += 17
+		var end = start
+		if isARange {
+			if end, err = r.readInt(offset, 16); err != nil {
+				return set, 0, err
+			}
+			offset += 16
+		}
+		if start < 1 || end < start || end > set.maxVendorID {
+			return set, 0, errors.New("invalid vendor range " + strconv.Itoa(start) + "-" + strconv.Itoa(end))
+		}
+		set.ranges = append(set.ranges, [2]int{start, end})
+	}
+	return set, offset, nil
+}
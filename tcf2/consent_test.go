@@ -0,0 +1,146 @@
+package tcf2_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"main.go/tcf2"
+)
+
+// the consent strings were built field by field after the IAB TCF v2 core segment layout, the purposes use
+// the 24 purpose bits and the vendors go up to 1000 to check both vendor section encodings
+const (
+	// purposes 1,2,3,4,7, purpose LI 2,7,9, bit field vendors 5,10, bit field vendor LI 8
+	bitFieldConsent = "CAAAAAAAAAAAAAKABAENAyCAAPIAAEKAAAYgAFAhAAgAgAA"
+	// purposes 1,2, purpose one treatment, range vendors 3 and 800-900, range vendor LI 2-4 and 1000
+	rangeConsent = "CAAAAAAAAAAAAAKABAENAyCAAMAAAAAAAIYgHCQAgABwMgA4QD6IAUAAgAEAfQAA"
+	// prebid-server test string, no purpose and no vendor
+	emptyConsent = "CPXxRfAPXxRfAAfKABENB-CgAAAAAAAAAAYgAAAAAAAA"
+)
+
+const maxTestVendorID = 1100
+
+func TestParse(t *testing.T) {
+	var rangeVendors = []int{3}
+	for vendorID := 800; vendorID <= 900; vendorID++ {
+		rangeVendors = append(rangeVendors, vendorID)
+	}
+	tests := []struct {
+		name                string
+		consent             string
+		purposeOneTreatment bool
+		purposes            []int
+		purposesLI          []int
+		vendors             []int
+		vendorsLI           []int
+	}{
+		{
+			name:       "bit field vendors",
+			consent:    bitFieldConsent,
+			purposes:   []int{1, 2, 3, 4, 7},
+			purposesLI: []int{2, 7, 9},
+			vendors:    []int{5, 10},
+			vendorsLI:  []int{8},
+		},
+		{
+			name:                "range vendors",
+			consent:             rangeConsent,
+			purposeOneTreatment: true,
+			purposes:            []int{1, 2},
+			vendors:             rangeVendors,
+			vendorsLI:           []int{2, 3, 4, 1000},
+		},
+		{
+			name:    "no consent",
+			consent: emptyConsent,
+		},
+		{
+			name:       "other segments are ignored",
+			consent:    bitFieldConsent + ".YAAAAAAAAAAA",
+			purposes:   []int{1, 2, 3, 4, 7},
+			purposesLI: []int{2, 7, 9},
+			vendors:    []int{5, 10},
+			vendorsLI:  []int{8},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			consent, err := tcf2.Parse(test.consent)
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			if consent.Version != 2 {
+				t.Errorf("Version = %d, want 2", consent.Version)
+			}
+			if consent.PurposeOneTreatment != test.purposeOneTreatment {
+				t.Errorf("PurposeOneTreatment = %v, want %v", consent.PurposeOneTreatment, test.purposeOneTreatment)
+			}
+			checkIDs(t, "purposes", collectIDs(24, consent.PurposeConsent), test.purposes)
+			checkIDs(t, "purposes LI", collectIDs(24, consent.PurposeLITransparency), test.purposesLI)
+			checkIDs(t, "vendors", collectIDs(maxTestVendorID, consent.VendorConsent), test.vendors)
+			checkIDs(t, "vendors LI", collectIDs(maxTestVendorID, consent.VendorLegitimateInterest), test.vendorsLI)
+		})
+	}
+}
+
+func TestParseOutOfRangeIDs(t *testing.T) {
+	consent, err := tcf2.Parse(bitFieldConsent)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	for _, purpose := range []int{-1, 0, 25} {
+		if consent.PurposeConsent(purpose) || consent.PurposeLITransparency(purpose) {
+			t.Errorf("purpose %d is out of range and must be false", purpose)
+		}
+	}
+	for _, vendorID := range []int{-1, 0, 11, 65535} {
+		if consent.VendorConsent(vendorID) {
+			t.Errorf("vendor %d is above the max vendor id and must be false", vendorID)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		consent string
+		err     string
+	}{
+		{name: "empty", consent: "", err: "empty"},
+		{name: "not base64url", consent: "not*base64", err: "not base64url"},
+		{name: "truncated before the purposes", consent: bitFieldConsent[:20], err: "too short"},
+		{name: "truncated vendor consents", consent: bitFieldConsent[:36], err: "too short"},
+		{name: "truncated vendor LI", consent: bitFieldConsent[:40], err: "vendor legitimate interest section"},
+		{name: "truncated vendor ranges", consent: rangeConsent[:52], err: "too short"},
+		{name: "version 1", consent: "BOEFEAyOEFEAyAHABDENAI4AAAB9vABAASA", err: "version 1 is not supported"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			consent, err := tcf2.Parse(test.consent)
+			if err == nil {
+				t.Fatalf("Parse returned %+v, want an error", consent)
+			}
+			if !strings.Contains(err.Error(), test.err) {
+				t.Errorf("error = %q, want it to contain %q", err, test.err)
+			}
+		})
+	}
+}
+
+func collectIDs(maxID int, contains func(int) bool) []int {
+	var ids []int
+	for id := 1; id <= maxID; id++ {
+		if contains(id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func checkIDs(t *testing.T, field string, got []int, want []int) {
+	t.Helper()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("%s = %v, want %v", field, got, want)
+	}
+}
@@ -0,0 +1,32 @@
+package constants
+
+import "testing"
+
+func TestToCountryAlpha2(t *testing.T) {
+	tests := []struct {
+		country string
+		alpha2  string
+		found   bool
+	}{
+		{country: "BRB", alpha2: "BB", found: true},
+		{country: "KOR", alpha2: "KR", found: true},
+		{country: "GRD", alpha2: "GD", found: true},
+		{country: "chn", alpha2: "CN", found: true},
+		{country: " deu ", alpha2: "DE", found: true},
+		{country: "fr", alpha2: "FR", found: true},
+		{country: "KR", alpha2: "KR", found: true},
+		{country: "XXX"},
+		{country: "UK"},
+		{country: "ZZ"},
+		{country: "KORE"},
+		{country: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.country, func(t *testing.T) {
+			alpha2, found := ToCountryAlpha2(test.country)
+			if alpha2 != test.alpha2 || found != test.found {
+				t.Errorf("ToCountryAlpha2(%q) = %q, %v, want %q, %v", test.country, alpha2, found, test.alpha2, test.found)
+			}
+		})
+	}
+}
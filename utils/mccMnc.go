@@ -0,0 +1,79 @@
+package constants
+
+import "strings"
+
+//go:generate go run ./mccmncgen -in mccMnc.csv -out mccMncList.go
+
+// huawei network.carrier
+const (
+	CarrierChinaUnicom  int32 = 1
+	CarrierChinaMobile  int32 = 2
+	CarrierChinaTelecom int32 = 3
+	CarrierOther        int32 = 99
+)
+
+// MccMnc: one mobile network of mccMnc.csv, Country is the upper case ISO 3166-1 alpha-2
+type MccMnc struct {
+	Mcc           string
+	Mnc           string
+	Country       string
+	Operator      string
+	HuaweiCarrier int32
+}
+
+// ParseMccMnc: openrtb device.mccmnc is "mcc-mnc", like "310-005"
+func ParseMccMnc(mccmnc string) (mcc string, mnc string, ok bool) {
+	var arr = strings.Split(strings.TrimSpace(mccmnc), "-")
+	if len(arr) != 2 || !isDigits(arr[0], 3, 3) || !isDigits(arr[1], 2, 3) {
+		return "", "", false
+	}
+	return arr[0], arr[1], true
+}
+
+// GetCountryByMcc: upper case ISO 3166-1 alpha-2 of the mcc
+func GetCountryByMcc(mcc string) (string, bool) {
+	if !isDigits(mcc, 3, 3) {
+		return "", false
+	}
+	var code = int(mcc[0]-'0')*100 + int(mcc[1]-'0')*10 + int(mcc[2]-'0')
+	if country, found := MccList[code]; found {
+		return strings.ToUpper(country), true
+	}
+	return "", false
+}
+
+// GetMccMnc: mnc is matched with and without the leading zero of 3 digit mncs, "05" and "005"
+func GetMccMnc(mcc string, mnc string) (MccMnc, bool) {
+	if mccMnc, found := MccMncList[mcc+mnc]; found {
+		return mccMnc, true
+	}
+	switch {
+	case len(mnc) == 2:
+		mccMnc, found := MccMncList[mcc+"0"+mnc]
+		return mccMnc, found
+	case len(mnc) == 3 && mnc[0] == '0':
+		mccMnc, found := MccMncList[mcc+mnc[1:]]
+		return mccMnc, found
+	}
+	return MccMnc{}, false
+}
+
+// GetHuaweiCarrier: the huawei network.carrier of the mobile network, CarrierOther when it is unknown
+func GetHuaweiCarrier(mcc string, mnc string) int32 {
+	if mccMnc, found := GetMccMnc(mcc, mnc); found {
+		return mccMnc.HuaweiCarrier
+	}
+	return CarrierOther
+}
+
+func isDigits(value string, minLen int, maxLen int) bool {
+	if len(value) < minLen || len(value) > maxLen {
+		return false
+	}
+	for _, c := range value {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,1153 @@
+// Code generated by mccmncgen from mccMnc.csv. DO NOT EDIT.
+
+package constants
+
+// MccList: mcc -> lower case ISO 3166-1 alpha-2
+var MccList = map[int]string{
+	202: "gr", //Greece
+	204: "nl", //Netherlands (Kingdom of the)
+	206: "be", //Belgium
+	208: "fr", //France
+	212: "mc", //Monaco (Principality of)
+	213: "ad", //Andorra (Principality of)
+	214: "es", //Spain
+	216: "hu", //Hungary (Republic of)
+	218: "ba", //Bosnia and Herzegovina
+	219: "hr", //Croatia (Republic of)
+	220: "rs", //Serbia and Montenegro
+	222: "it", //Italy
+	225: "va", //Vatican City State
+	226: "ro", //Romania
+	228: "ch", //Switzerland (Confederation of)
+	230: "cz", //Czech Republic
+	231: "sk", //Slovak Republic
+	232: "at", //Austria
+	234: "gb", //United Kingdom of Great Britain and Northern Ireland
+	235: "gb", //United Kingdom of Great Britain and Northern Ireland
+	238: "dk", //Denmark
+	240: "se", //Sweden
+	242: "no", //Norway
+	244: "fi", //Finland
+	246: "lt", //Lithuania (Republic of)
+	247: "lv", //Latvia (Republic of)
+	248: "ee", //Estonia (Republic of)
+	250: "ru", //Russian Federation
+	255: "ua", //Ukraine
+	257: "by", //Belarus (Republic of)
+	259: "md", //Moldova (Republic of)
+	260: "pl", //Poland (Republic of)
+	262: "de", //Germany (Federal Republic of)
+	266: "gi", //Gibraltar
+	268: "pt", //Portugal
+	270: "lu", //Luxembourg
+	272: "ie", //Ireland
+	274: "is", //Iceland
+	276: "al", //Albania (Republic of)
+	278: "mt", //Malta
+	280: "cy", //Cyprus (Republic of)
+	282: "ge", //Georgia
+	283: "am", //Armenia (Republic of)
+	284: "bg", //Bulgaria (Republic of)
+	286: "tr", //Turkey
+	288: "fo", //Faroe Islands
+	289: "ge", //Abkhazia (Georgia)
+	290: "gl", //Greenland (Denmark)
+	292: "sm", //San Marino (Republic of)
+	293: "si", //Slovenia (Republic of)
+	294: "mk", //The Former Yugoslav Republic of Macedonia
+	295: "li", //Liechtenstein (Principality of)
+	297: "me", //Montenegro (Republic of)
+	302: "ca", //Canada
+	308: "pm", //Saint Pierre and Miquelon (Collectivit territoriale de la Rpublique franaise)
+	310: "us", //United States of America
+	311: "us", //United States of America
+	312: "us", //United States of America
+	313: "us", //United States of America
+	314: "us", //United States of America
+	315: "us", //United States of America
+	316: "us", //United States of America
+	330: "pr", //Puerto Rico
+	332: "vi", //United States Virgin Islands
+	334: "mx", //Mexico
+	338: "jm", //Jamaica
+	340: "gp", //Guadeloupe (French Department of)
+	342: "bb", //Barbados
+	344: "ag", //Antigua and Barbuda
+	346: "ky", //Cayman Islands
+	348: "vg", //British Virgin Islands
+	350: "bm", //Bermuda
+	352: "gd", //Grenada
+	354: "ms", //Montserrat
+	356: "kn", //Saint Kitts and Nevis
+	358: "lc", //Saint Lucia
+	360: "vc", //Saint Vincent and the Grenadines
+	362: "ai", //Netherlands Antilles
+	363: "aw", //Aruba
+	364: "bs", //Bahamas (Commonwealth of the)
+	365: "ai", //Anguilla
+	366: "dm", //Dominica (Commonwealth of)
+	368: "cu", //Cuba
+	370: "do", //Dominican Republic
+	372: "ht", //Haiti (Republic of)
+	374: "tt", //Trinidad and Tobago
+	376: "tc", //Turks and Caicos Islands
+	400: "az", //Azerbaijani Republic
+	401: "kz", //Kazakhstan (Republic of)
+	402: "bt", //Bhutan (Kingdom of)
+	404: "in", //India (Republic of)
+	405: "in", //India (Republic of)
+	406: "in", //India (Republic of)
+	410: "pk", //Pakistan (Islamic Republic of)
+	412: "af", //Afghanistan
+	413: "lk", //Sri Lanka (Democratic Socialist Republic of)
+	414: "mm", //Myanmar (Union of)
+	415: "lb", //Lebanon
+	416: "jo", //Jordan (Hashemite Kingdom of)
+	417: "sy", //Syrian Arab Republic
+	418: "iq", //Iraq (Republic of)
+	419: "kw", //Kuwait (State of)
+	420: "sa", //Saudi Arabia (Kingdom of)
+	421: "ye", //Yemen (Republic of)
+	422: "om", //Oman (Sultanate of)
+	423: "ps", //Palestine
+	424: "ae", //United Arab Emirates
+	425: "il", //Israel (State of)
+	426: "bh", //Bahrain (Kingdom of)
+	427: "qa", //Qatar (State of)
+	428: "mn", //Mongolia
+	429: "np", //Nepal
+	430: "ae", //United Arab Emirates
+	431: "ae", //United Arab Emirates
+	432: "ir", //Iran (Islamic Republic of)
+	434: "uz", //Uzbekistan (Republic of)
+	436: "tj", //Tajikistan (Republic of)
+	437: "kg", //Kyrgyz Republic
+	438: "tm", //Turkmenistan
+	440: "jp", //Japan
+	441: "jp", //Japan
+	450: "kr", //Korea (Republic of)
+	452: "vn", //Viet Nam (Socialist Republic of)
+	454: "hk", //Hong Kong, China
+	455: "mo", //Macao, China
+	456: "kh", //Cambodia (Kingdom of)
+	457: "la", //Lao People's Democratic Republic
+	460: "cn", //China (People's Republic of)
+	461: "cn", //China (People's Republic of)
+	466: "tw", //Taiwan, China
+	467: "kp", //Democratic People's Republic of Korea
+	470: "bd", //Bangladesh (People's Republic of)
+	472: "mv", //Maldives (Republic of)
+	502: "my", //Malaysia
+	505: "au", //Australia
+	510: "id", //Indonesia (Republic of)
+	514: "tl", //Democratic Republic of Timor-Leste
+	515: "ph", //Philippines (Republic of the)
+	520: "th", //Thailand
+	525: "sg", //Singapore (Republic of)
+	528: "bn", //Brunei Darussalam
+	530: "nz", //New Zealand
+	534: "mp", //Northern Mariana Islands (Commonwealth of the)
+	535: "gu", //Guam
+	536: "nr", //Nauru (Republic of)
+	537: "pg", //Papua New Guinea
+	539: "to", //Tonga (Kingdom of)
+	540: "sb", //Solomon Islands
+	541: "vu", //Vanuatu (Republic of)
+	542: "fj", //Fiji (Republic of)
+	543: "wf", //Wallis and Futuna (Territoire franais d'outre-mer)
+	544: "as", //American Samoa
+	545: "ki", //Kiribati (Republic of)
+	546: "nc", //New Caledonia (Territoire franais d'outre-mer)
+	547: "pf", //French Polynesia (Territoire franais d'outre-mer)
+	548: "ck", //Cook Islands
+	549: "ws", //Samoa (Independent State of)
+	550: "fm", //Micronesia (Federated States of)
+	551: "mh", //Marshall Islands (Republic of the)
+	552: "pw", //Palau (Republic of)
+	553: "tv", //Tuvalu
+	555: "nu", //Niue
+	602: "eg", //Egypt (Arab Republic of)
+	603: "dz", //Algeria (People's Democratic Republic of)
+	604: "ma", //Morocco (Kingdom of)
+	605: "tn", //Tunisia
+	606: "ly", //Libya (Socialist People's Libyan Arab Jamahiriya)
+	607: "gm", //Gambia (Republic of the)
+	608: "sn", //Senegal (Republic of)
+	609: "mr", //Mauritania (Islamic Republic of)
+	610: "ml", //Mali (Republic of)
+	611: "gn", //Guinea (Republic of)
+	612: "ci", //Côte d'Ivoire (Republic of)
+	613: "bf", //Burkina Faso
+	614: "ne", //Niger (Republic of the)
+	615: "tg", //Togolese Republic
+	616: "bj", //Benin (Republic of)
+	617: "mu", //Mauritius (Republic of)
+	618: "lr", //Liberia (Republic of)
+	619: "sl", //Sierra Leone
+	620: "gh", //Ghana
+	621: "ng", //Nigeria (Federal Republic of)
+	622: "td", //Chad (Republic of)
+	623: "cf", //Central African Republic
+	624: "cm", //Cameroon (Republic of)
+	625: "cv", //Cape Verde (Republic of)
+	626: "st", //Sao Tome and Principe (Democratic Republic of)
+	627: "gq", //Equatorial Guinea (Republic of)
+	628: "ga", //Gabonese Republic
+	629: "cg", //Congo (Republic of the)
+	630: "cg", //Democratic Republic of the Congo
+	631: "ao", //Angola (Republic of)
+	632: "gw", //Guinea-Bissau (Republic of)
+	633: "sc", //Seychelles (Republic of)
+	634: "sd", //Sudan (Republic of the)
+	635: "rw", //Rwanda (Republic of)
+	636: "et", //Ethiopia (Federal Democratic Republic of)
+	637: "so", //Somali Democratic Republic
+	638: "dj", //Djibouti (Republic of)
+	639: "ke", //Kenya (Republic of)
+	640: "tz", //Tanzania (United Republic of)
+	641: "ug", //Uganda (Republic of)
+	642: "bi", //Burundi (Republic of)
+	643: "mz", //Mozambique (Republic of)
+	645: "zm", //Zambia (Republic of)
+	646: "mg", //Madagascar (Republic of)
+	647: "re", //Reunion (French Department of)
+	648: "zw", //Zimbabwe (Republic of)
+	649: "na", //Namibia (Republic of)
+	650: "mw", //Malawi
+	651: "ls", //Lesotho (Kingdom of)
+	652: "bw", //Botswana (Republic of)
+	653: "sz", //Swaziland (Kingdom of)
+	654: "km", //Comoros (Union of the)
+	655: "za", //South Africa (Republic of)
+	657: "er", //Eritrea
+	658: "sh", //Saint Helena, Ascension and Tristan da Cunha
+	659: "ss", //South Sudan (Republic of)
+	702: "bz", //Belize
+	704: "gt", //Guatemala (Republic of)
+	706: "sv", //El Salvador (Republic of)
+	708: "hn", //Honduras (Republic of)
+	710: "ni", //Nicaragua
+	712: "cr", //Costa Rica
+	714: "pa", //Panama (Republic of)
+	716: "pe", //Peru
+	722: "ar", //Argentine Republic
+	724: "br", //Brazil (Federative Republic of)
+	730: "cl", //Chile
+	732: "co", //Colombia (Republic of)
+	734: "ve", //Venezuela (Bolivarian Republic of)
+	736: "bo", //Bolivia (Republic of)
+	738: "gy", //Guyana
+	740: "ec", //Ecuador
+	742: "gf", //French Guiana (French Department of)
+	744: "py", //Paraguay (Republic of)
+	746: "sr", //Suriname (Republic of)
+	748: "uy", //Uruguay (Eastern Republic of)
+	750: "fk", //Falkland Islands (Malvinas)
+}
+
+// MccMncList: mcc + mnc -> operator
+var MccMncList = map[string]MccMnc{
+	"20201":  {Mcc: "202", Mnc: "01", Country: "GR", Operator: "Cosmote", HuaweiCarrier: 99},
+	"20205":  {Mcc: "202", Mnc: "05", Country: "GR", Operator: "Vodafone", HuaweiCarrier: 99},
+	"20210":  {Mcc: "202", Mnc: "10", Country: "GR", Operator: "Nova", HuaweiCarrier: 99},
+	"20214":  {Mcc: "202", Mnc: "14", Country: "GR", Operator: "Cyta Hellas", HuaweiCarrier: 99},
+	"20402":  {Mcc: "204", Mnc: "02", Country: "NL", Operator: "Tele2", HuaweiCarrier: 99},
+	"20404":  {Mcc: "204", Mnc: "04", Country: "NL", Operator: "Vodafone", HuaweiCarrier: 99},
+	"20408":  {Mcc: "204", Mnc: "08", Country: "NL", Operator: "KPN", HuaweiCarrier: 99},
+	"20416":  {Mcc: "204", Mnc: "16", Country: "NL", Operator: "Odido", HuaweiCarrier: 99},
+	"20420":  {Mcc: "204", Mnc: "20", Country: "NL", Operator: "T-Mobile", HuaweiCarrier: 99},
+	"20601":  {Mcc: "206", Mnc: "01", Country: "BE", Operator: "Proximus", HuaweiCarrier: 99},
+	"20605":  {Mcc: "206", Mnc: "05", Country: "BE", Operator: "Telenet", HuaweiCarrier: 99},
+	"20610":  {Mcc: "206", Mnc: "10", Country: "BE", Operator: "Orange", HuaweiCarrier: 99},
+	"20620":  {Mcc: "206", Mnc: "20", Country: "BE", Operator: "Base", HuaweiCarrier: 99},
+	"20801":  {Mcc: "208", Mnc: "01", Country: "FR", Operator: "Orange", HuaweiCarrier: 99},
+	"20802":  {Mcc: "208", Mnc: "02", Country: "FR", Operator: "Orange", HuaweiCarrier: 99},
+	"20809":  {Mcc: "208", Mnc: "09", Country: "FR", Operator: "SFR", HuaweiCarrier: 99},
+	"20810":  {Mcc: "208", Mnc: "10", Country: "FR", Operator: "SFR", HuaweiCarrier: 99},
+	"20811":  {Mcc: "208", Mnc: "11", Country: "FR", Operator: "SFR", HuaweiCarrier: 99},
+	"20813":  {Mcc: "208", Mnc: "13", Country: "FR", Operator: "SFR", HuaweiCarrier: 99},
+	"20815":  {Mcc: "208", Mnc: "15", Country: "FR", Operator: "Free Mobile", HuaweiCarrier: 99},
+	"20820":  {Mcc: "208", Mnc: "20", Country: "FR", Operator: "Bouygues Telecom", HuaweiCarrier: 99},
+	"20888":  {Mcc: "208", Mnc: "88", Country: "FR", Operator: "Bouygues Telecom", HuaweiCarrier: 99},
+	"21210":  {Mcc: "212", Mnc: "10", Country: "MC", Operator: "Monaco Telecom", HuaweiCarrier: 99},
+	"21303":  {Mcc: "213", Mnc: "03", Country: "AD", Operator: "Andorra Telecom", HuaweiCarrier: 99},
+	"21401":  {Mcc: "214", Mnc: "01", Country: "ES", Operator: "Vodafone", HuaweiCarrier: 99},
+	"21403":  {Mcc: "214", Mnc: "03", Country: "ES", Operator: "Orange", HuaweiCarrier: 99},
+	"21404":  {Mcc: "214", Mnc: "04", Country: "ES", Operator: "Yoigo", HuaweiCarrier: 99},
+	"21405":  {Mcc: "214", Mnc: "05", Country: "ES", Operator: "Movistar", HuaweiCarrier: 99},
+	"21406":  {Mcc: "214", Mnc: "06", Country: "ES", Operator: "Vodafone", HuaweiCarrier: 99},
+	"21407":  {Mcc: "214", Mnc: "07", Country: "ES", Operator: "Movistar", HuaweiCarrier: 99},
+	"21433":  {Mcc: "214", Mnc: "33", Country: "ES", Operator: "Euskaltel", HuaweiCarrier: 99},
+	"21601":  {Mcc: "216", Mnc: "01", Country: "HU", Operator: "Yettel", HuaweiCarrier: 99},
+	"21620":  {Mcc: "216", Mnc: "20", Country: "HU", Operator: "Yettel", HuaweiCarrier: 99},
+	"21630":  {Mcc: "216", Mnc: "30", Country: "HU", Operator: "Telekom", HuaweiCarrier: 99},
+	"21670":  {Mcc: "216", Mnc: "70", Country: "HU", Operator: "Vodafone", HuaweiCarrier: 99},
+	"21803":  {Mcc: "218", Mnc: "03", Country: "BA", Operator: "HT-ERONET", HuaweiCarrier: 99},
+	"21805":  {Mcc: "218", Mnc: "05", Country: "BA", Operator: "m:tel", HuaweiCarrier: 99},
+	"21890":  {Mcc: "218", Mnc: "90", Country: "BA", Operator: "BH Mobile", HuaweiCarrier: 99},
+	"21901":  {Mcc: "219", Mnc: "01", Country: "HR", Operator: "Hrvatski Telekom", HuaweiCarrier: 99},
+	"21902":  {Mcc: "219", Mnc: "02", Country: "HR", Operator: "Telemach", HuaweiCarrier: 99},
+	"21910":  {Mcc: "219", Mnc: "10", Country: "HR", Operator: "A1", HuaweiCarrier: 99},
+	"22001":  {Mcc: "220", Mnc: "01", Country: "RS", Operator: "Yettel", HuaweiCarrier: 99},
+	"22003":  {Mcc: "220", Mnc: "03", Country: "RS", Operator: "mts", HuaweiCarrier: 99},
+	"22005":  {Mcc: "220", Mnc: "05", Country: "RS", Operator: "A1", HuaweiCarrier: 99},
+	"22201":  {Mcc: "222", Mnc: "01", Country: "IT", Operator: "TIM", HuaweiCarrier: 99},
+	"22210":  {Mcc: "222", Mnc: "10", Country: "IT", Operator: "Vodafone", HuaweiCarrier: 99},
+	"22250":  {Mcc: "222", Mnc: "50", Country: "IT", Operator: "Iliad", HuaweiCarrier: 99},
+	"22288":  {Mcc: "222", Mnc: "88", Country: "IT", Operator: "Wind Tre", HuaweiCarrier: 99},
+	"22299":  {Mcc: "222", Mnc: "99", Country: "IT", Operator: "3 Italia", HuaweiCarrier: 99},
+	"22501":  {Mcc: "225", Mnc: "01", Country: "VA", Operator: "Vatican Telecom", HuaweiCarrier: 99},
+	"22601":  {Mcc: "226", Mnc: "01", Country: "RO", Operator: "Vodafone", HuaweiCarrier: 99},
+	"22603":  {Mcc: "226", Mnc: "03", Country: "RO", Operator: "Telekom", HuaweiCarrier: 99},
+	"22605":  {Mcc: "226", Mnc: "05", Country: "RO", Operator: "Digi.Mobil", HuaweiCarrier: 99},
+	"22606":  {Mcc: "226", Mnc: "06", Country: "RO", Operator: "Telekom", HuaweiCarrier: 99},
+	"22610":  {Mcc: "226", Mnc: "10", Country: "RO", Operator: "Orange", HuaweiCarrier: 99},
+	"22801":  {Mcc: "228", Mnc: "01", Country: "CH", Operator: "Swisscom", HuaweiCarrier: 99},
+	"22802":  {Mcc: "228", Mnc: "02", Country: "CH", Operator: "Sunrise", HuaweiCarrier: 99},
+	"22803":  {Mcc: "228", Mnc: "03", Country: "CH", Operator: "Salt", HuaweiCarrier: 99},
+	"22808":  {Mcc: "228", Mnc: "08", Country: "CH", Operator: "TelCommunication Services", HuaweiCarrier: 99},
+	"23001":  {Mcc: "230", Mnc: "01", Country: "CZ", Operator: "T-Mobile", HuaweiCarrier: 99},
+	"23002":  {Mcc: "230", Mnc: "02", Country: "CZ", Operator: "O2", HuaweiCarrier: 99},
+	"23003":  {Mcc: "230", Mnc: "03", Country: "CZ", Operator: "Vodafone", HuaweiCarrier: 99},
+	"23004":  {Mcc: "230", Mnc: "04", Country: "CZ", Operator: "Nordic Telecom", HuaweiCarrier: 99},
+	"23101":  {Mcc: "231", Mnc: "01", Country: "SK", Operator: "Orange", HuaweiCarrier: 99},
+	"23102":  {Mcc: "231", Mnc: "02", Country: "SK", Operator: "Telekom", HuaweiCarrier: 99},
+	"23104":  {Mcc: "231", Mnc: "04", Country: "SK", Operator: "Telekom", HuaweiCarrier: 99},
+	"23106":  {Mcc: "231", Mnc: "06", Country: "SK", Operator: "O2", HuaweiCarrier: 99},
+	"23201":  {Mcc: "232", Mnc: "01", Country: "AT", Operator: "A1", HuaweiCarrier: 99},
+	"23203":  {Mcc: "232", Mnc: "03", Country: "AT", Operator: "Magenta", HuaweiCarrier: 99},
+	"23205":  {Mcc: "232", Mnc: "05", Country: "AT", Operator: "Drei", HuaweiCarrier: 99},
+	"23207":  {Mcc: "232", Mnc: "07", Country: "AT", Operator: "Magenta", HuaweiCarrier: 99},
+	"23210":  {Mcc: "232", Mnc: "10", Country: "AT", Operator: "Drei", HuaweiCarrier: 99},
+	"23402":  {Mcc: "234", Mnc: "02", Country: "GB", Operator: "O2", HuaweiCarrier: 99},
+	"23410":  {Mcc: "234", Mnc: "10", Country: "GB", Operator: "O2", HuaweiCarrier: 99},
+	"23411":  {Mcc: "234", Mnc: "11", Country: "GB", Operator: "O2", HuaweiCarrier: 99},
+	"23415":  {Mcc: "234", Mnc: "15", Country: "GB", Operator: "Vodafone", HuaweiCarrier: 99},
+	"23420":  {Mcc: "234", Mnc: "20", Country: "GB", Operator: "Three", HuaweiCarrier: 99},
+	"23430":  {Mcc: "234", Mnc: "30", Country: "GB", Operator: "EE", HuaweiCarrier: 99},
+	"23433":  {Mcc: "234", Mnc: "33", Country: "GB", Operator: "EE", HuaweiCarrier: 99},
+	"23458":  {Mcc: "234", Mnc: "58", Country: "GB", Operator: "Manx Telecom", HuaweiCarrier: 99},
+	"23500":  {Mcc: "235", Mnc: "00", Country: "GB", Operator: "Mundio Mobile", HuaweiCarrier: 99},
+	"23801":  {Mcc: "238", Mnc: "01", Country: "DK", Operator: "TDC", HuaweiCarrier: 99},
+	"23802":  {Mcc: "238", Mnc: "02", Country: "DK", Operator: "Telenor", HuaweiCarrier: 99},
+	"23806":  {Mcc: "238", Mnc: "06", Country: "DK", Operator: "3", HuaweiCarrier: 99},
+	"23820":  {Mcc: "238", Mnc: "20", Country: "DK", Operator: "Telia", HuaweiCarrier: 99},
+	"24001":  {Mcc: "240", Mnc: "01", Country: "SE", Operator: "Telia", HuaweiCarrier: 99},
+	"24002":  {Mcc: "240", Mnc: "02", Country: "SE", Operator: "3", HuaweiCarrier: 99},
+	"24007":  {Mcc: "240", Mnc: "07", Country: "SE", Operator: "Tele2", HuaweiCarrier: 99},
+	"24008":  {Mcc: "240", Mnc: "08", Country: "SE", Operator: "Telenor", HuaweiCarrier: 99},
+	"24201":  {Mcc: "242", Mnc: "01", Country: "NO", Operator: "Telenor", HuaweiCarrier: 99},
+	"24202":  {Mcc: "242", Mnc: "02", Country: "NO", Operator: "Telia", HuaweiCarrier: 99},
+	"24214":  {Mcc: "242", Mnc: "14", Country: "NO", Operator: "ICE", HuaweiCarrier: 99},
+	"24405":  {Mcc: "244", Mnc: "05", Country: "FI", Operator: "Elisa", HuaweiCarrier: 99},
+	"24412":  {Mcc: "244", Mnc: "12", Country: "FI", Operator: "DNA", HuaweiCarrier: 99},
+	"24421":  {Mcc: "244", Mnc: "21", Country: "FI", Operator: "Elisa", HuaweiCarrier: 99},
+	"24491":  {Mcc: "244", Mnc: "91", Country: "FI", Operator: "Telia", HuaweiCarrier: 99},
+	"24601":  {Mcc: "246", Mnc: "01", Country: "LT", Operator: "Telia", HuaweiCarrier: 99},
+	"24602":  {Mcc: "246", Mnc: "02", Country: "LT", Operator: "Bite", HuaweiCarrier: 99},
+	"24603":  {Mcc: "246", Mnc: "03", Country: "LT", Operator: "Tele2", HuaweiCarrier: 99},
+	"24701":  {Mcc: "247", Mnc: "01", Country: "LV", Operator: "LMT", HuaweiCarrier: 99},
+	"24702":  {Mcc: "247", Mnc: "02", Country: "LV", Operator: "Tele2", HuaweiCarrier: 99},
+	"24705":  {Mcc: "247", Mnc: "05", Country: "LV", Operator: "Bite", HuaweiCarrier: 99},
+	"24801":  {Mcc: "248", Mnc: "01", Country: "EE", Operator: "Telia", HuaweiCarrier: 99},
+	"24802":  {Mcc: "248", Mnc: "02", Country: "EE", Operator: "Elisa", HuaweiCarrier: 99},
+	"24803":  {Mcc: "248", Mnc: "03", Country: "EE", Operator: "Tele2", HuaweiCarrier: 99},
+	"25001":  {Mcc: "250", Mnc: "01", Country: "RU", Operator: "MTS", HuaweiCarrier: 99},
+	"25002":  {Mcc: "250", Mnc: "02", Country: "RU", Operator: "MegaFon", HuaweiCarrier: 99},
+	"25003":  {Mcc: "250", Mnc: "03", Country: "RU", Operator: "NCC", HuaweiCarrier: 99},
+	"25005":  {Mcc: "250", Mnc: "05", Country: "RU", Operator: "ETK", HuaweiCarrier: 99},
+	"25007":  {Mcc: "250", Mnc: "07", Country: "RU", Operator: "SMARTS", HuaweiCarrier: 99},
+	"25011":  {Mcc: "250", Mnc: "11", Country: "RU", Operator: "Yota", HuaweiCarrier: 99},
+	"25012":  {Mcc: "250", Mnc: "12", Country: "RU", Operator: "Baykalwestcom", HuaweiCarrier: 99},
+	"25013":  {Mcc: "250", Mnc: "13", Country: "RU", Operator: "Kuban GSM", HuaweiCarrier: 99},
+	"25015":  {Mcc: "250", Mnc: "15", Country: "RU", Operator: "SMARTS", HuaweiCarrier: 99},
+	"25016":  {Mcc: "250", Mnc: "16", Country: "RU", Operator: "NTC", HuaweiCarrier: 99},
+	"25017":  {Mcc: "250", Mnc: "17", Country: "RU", Operator: "Utel", HuaweiCarrier: 99},
+	"25019":  {Mcc: "250", Mnc: "19", Country: "RU", Operator: "INDIGO", HuaweiCarrier: 99},
+	"25020":  {Mcc: "250", Mnc: "20", Country: "RU", Operator: "Tele2", HuaweiCarrier: 99},
+	"25028":  {Mcc: "250", Mnc: "28", Country: "RU", Operator: "Beeline", HuaweiCarrier: 99},
+	"25035":  {Mcc: "250", Mnc: "35", Country: "RU", Operator: "MOTIV", HuaweiCarrier: 99},
+	"25038":  {Mcc: "250", Mnc: "38", Country: "RU", Operator: "Tambov GSM", HuaweiCarrier: 99},
+	"25039":  {Mcc: "250", Mnc: "39", Country: "RU", Operator: "Rostelecom", HuaweiCarrier: 99},
+	"25044":  {Mcc: "250", Mnc: "44", Country: "RU", Operator: "Stavtelesot", HuaweiCarrier: 99},
+	"25092":  {Mcc: "250", Mnc: "92", Country: "RU", Operator: "Primtelefon", HuaweiCarrier: 99},
+	"25093":  {Mcc: "250", Mnc: "93", Country: "RU", Operator: "Telecom XXI", HuaweiCarrier: 99},
+	"25099":  {Mcc: "250", Mnc: "99", Country: "RU", Operator: "Beeline", HuaweiCarrier: 99},
+	"25501":  {Mcc: "255", Mnc: "01", Country: "UA", Operator: "Vodafone", HuaweiCarrier: 99},
+	"25503":  {Mcc: "255", Mnc: "03", Country: "UA", Operator: "Kyivstar", HuaweiCarrier: 99},
+	"25506":  {Mcc: "255", Mnc: "06", Country: "UA", Operator: "lifecell", HuaweiCarrier: 99},
+	"25507":  {Mcc: "255", Mnc: "07", Country: "UA", Operator: "3Mob", HuaweiCarrier: 99},
+	"25701":  {Mcc: "257", Mnc: "01", Country: "BY", Operator: "A1", HuaweiCarrier: 99},
+	"25702":  {Mcc: "257", Mnc: "02", Country: "BY", Operator: "MTS", HuaweiCarrier: 99},
+	"25704":  {Mcc: "257", Mnc: "04", Country: "BY", Operator: "life:)", HuaweiCarrier: 99},
+	"25901":  {Mcc: "259", Mnc: "01", Country: "MD", Operator: "Orange", HuaweiCarrier: 99},
+	"25902":  {Mcc: "259", Mnc: "02", Country: "MD", Operator: "Moldcell", HuaweiCarrier: 99},
+	"25905":  {Mcc: "259", Mnc: "05", Country: "MD", Operator: "Unite", HuaweiCarrier: 99},
+	"26001":  {Mcc: "260", Mnc: "01", Country: "PL", Operator: "Plus", HuaweiCarrier: 99},
+	"26002":  {Mcc: "260", Mnc: "02", Country: "PL", Operator: "T-Mobile", HuaweiCarrier: 99},
+	"26003":  {Mcc: "260", Mnc: "03", Country: "PL", Operator: "Orange", HuaweiCarrier: 99},
+	"26006":  {Mcc: "260", Mnc: "06", Country: "PL", Operator: "Play", HuaweiCarrier: 99},
+	"26034":  {Mcc: "260", Mnc: "34", Country: "PL", Operator: "T-Mobile", HuaweiCarrier: 99},
+	"26201":  {Mcc: "262", Mnc: "01", Country: "DE", Operator: "Telekom", HuaweiCarrier: 99},
+	"26202":  {Mcc: "262", Mnc: "02", Country: "DE", Operator: "Vodafone", HuaweiCarrier: 99},
+	"26203":  {Mcc: "262", Mnc: "03", Country: "DE", Operator: "O2", HuaweiCarrier: 99},
+	"26207":  {Mcc: "262", Mnc: "07", Country: "DE", Operator: "O2", HuaweiCarrier: 99},
+	"26208":  {Mcc: "262", Mnc: "08", Country: "DE", Operator: "O2", HuaweiCarrier: 99},
+	"26223":  {Mcc: "262", Mnc: "23", Country: "DE", Operator: "1&1", HuaweiCarrier: 99},
+	"26601":  {Mcc: "266", Mnc: "01", Country: "GI", Operator: "GibTel", HuaweiCarrier: 99},
+	"26801":  {Mcc: "268", Mnc: "01", Country: "PT", Operator: "Vodafone", HuaweiCarrier: 99},
+	"26803":  {Mcc: "268", Mnc: "03", Country: "PT", Operator: "MEO", HuaweiCarrier: 99},
+	"26806":  {Mcc: "268", Mnc: "06", Country: "PT", Operator: "NOS", HuaweiCarrier: 99},
+	"27001":  {Mcc: "270", Mnc: "01", Country: "LU", Operator: "POST", HuaweiCarrier: 99},
+	"27077":  {Mcc: "270", Mnc: "77", Country: "LU", Operator: "Tango", HuaweiCarrier: 99},
+	"27099":  {Mcc: "270", Mnc: "99", Country: "LU", Operator: "Orange", HuaweiCarrier: 99},
+	"27201":  {Mcc: "272", Mnc: "01", Country: "IE", Operator: "Vodafone", HuaweiCarrier: 99},
+	"27202":  {Mcc: "272", Mnc: "02", Country: "IE", Operator: "Three", HuaweiCarrier: 99},
+	"27203":  {Mcc: "272", Mnc: "03", Country: "IE", Operator: "Eir", HuaweiCarrier: 99},
+	"27205":  {Mcc: "272", Mnc: "05", Country: "IE", Operator: "Three", HuaweiCarrier: 99},
+	"27401":  {Mcc: "274", Mnc: "01", Country: "IS", Operator: "Siminn", HuaweiCarrier: 99},
+	"27402":  {Mcc: "274", Mnc: "02", Country: "IS", Operator: "Vodafone", HuaweiCarrier: 99},
+	"27411":  {Mcc: "274", Mnc: "11", Country: "IS", Operator: "Nova", HuaweiCarrier: 99},
+	"27601":  {Mcc: "276", Mnc: "01", Country: "AL", Operator: "One", HuaweiCarrier: 99},
+	"27602":  {Mcc: "276", Mnc: "02", Country: "AL", Operator: "Vodafone", HuaweiCarrier: 99},
+	"27603":  {Mcc: "276", Mnc: "03", Country: "AL", Operator: "Eagle Mobile", HuaweiCarrier: 99},
+	"27801":  {Mcc: "278", Mnc: "01", Country: "MT", Operator: "Epic", HuaweiCarrier: 99},
+	"27821":  {Mcc: "278", Mnc: "21", Country: "MT", Operator: "GO", HuaweiCarrier: 99},
+	"28001":  {Mcc: "280", Mnc: "01", Country: "CY", Operator: "Cytamobile-Vodafone", HuaweiCarrier: 99},
+	"28010":  {Mcc: "280", Mnc: "10", Country: "CY", Operator: "Epic", HuaweiCarrier: 99},
+	"28201":  {Mcc: "282", Mnc: "01", Country: "GE", Operator: "Geocell", HuaweiCarrier: 99},
+	"28202":  {Mcc: "282", Mnc: "02", Country: "GE", Operator: "MagtiCom", HuaweiCarrier: 99},
+	"28204":  {Mcc: "282", Mnc: "04", Country: "GE", Operator: "Beeline", HuaweiCarrier: 99},
+	"28301":  {Mcc: "283", Mnc: "01", Country: "AM", Operator: "Beeline", HuaweiCarrier: 99},
+	"28305":  {Mcc: "283", Mnc: "05", Country: "AM", Operator: "Ucom", HuaweiCarrier: 99},
+	"28310":  {Mcc: "283", Mnc: "10", Country: "AM", Operator: "Viva-MTS", HuaweiCarrier: 99},
+	"28401":  {Mcc: "284", Mnc: "01", Country: "BG", Operator: "A1", HuaweiCarrier: 99},
+	"28403":  {Mcc: "284", Mnc: "03", Country: "BG", Operator: "Vivacom", HuaweiCarrier: 99},
+	"28405":  {Mcc: "284", Mnc: "05", Country: "BG", Operator: "Yettel", HuaweiCarrier: 99},
+	"28601":  {Mcc: "286", Mnc: "01", Country: "TR", Operator: "Turkcell", HuaweiCarrier: 99},
+	"28602":  {Mcc: "286", Mnc: "02", Country: "TR", Operator: "Vodafone", HuaweiCarrier: 99},
+	"28603":  {Mcc: "286", Mnc: "03", Country: "TR", Operator: "Turk Telekom", HuaweiCarrier: 99},
+	"28604":  {Mcc: "286", Mnc: "04", Country: "TR", Operator: "Aycell", HuaweiCarrier: 99},
+	"28801":  {Mcc: "288", Mnc: "01", Country: "FO", Operator: "Faroese Telecom", HuaweiCarrier: 99},
+	"28802":  {Mcc: "288", Mnc: "02", Country: "FO", Operator: "Hey", HuaweiCarrier: 99},
+	"28967":  {Mcc: "289", Mnc: "67", Country: "GE", Operator: "Aquafon", HuaweiCarrier: 99},
+	"28988":  {Mcc: "289", Mnc: "88", Country: "GE", Operator: "A-Mobile", HuaweiCarrier: 99},
+	"29001":  {Mcc: "290", Mnc: "01", Country: "GL", Operator: "Tusass", HuaweiCarrier: 99},
+	"29201":  {Mcc: "292", Mnc: "01", Country: "SM", Operator: "PRIMA", HuaweiCarrier: 99},
+	"29340":  {Mcc: "293", Mnc: "40", Country: "SI", Operator: "A1", HuaweiCarrier: 99},
+	"29341":  {Mcc: "293", Mnc: "41", Country: "SI", Operator: "Telekom Slovenije", HuaweiCarrier: 99},
+	"29364":  {Mcc: "293", Mnc: "64", Country: "SI", Operator: "T-2", HuaweiCarrier: 99},
+	"29370":  {Mcc: "293", Mnc: "70", Country: "SI", Operator: "Telemach", HuaweiCarrier: 99},
+	"29401":  {Mcc: "294", Mnc: "01", Country: "MK", Operator: "Telekom.mk", HuaweiCarrier: 99},
+	"29402":  {Mcc: "294", Mnc: "02", Country: "MK", Operator: "one", HuaweiCarrier: 99},
+	"29403":  {Mcc: "294", Mnc: "03", Country: "MK", Operator: "A1", HuaweiCarrier: 99},
+	"29501":  {Mcc: "295", Mnc: "01", Country: "LI", Operator: "Swisscom", HuaweiCarrier: 99},
+	"29502":  {Mcc: "295", Mnc: "02", Country: "LI", Operator: "7acht", HuaweiCarrier: 99},
+	"29505":  {Mcc: "295", Mnc: "05", Country: "LI", Operator: "FL1", HuaweiCarrier: 99},
+	"29701":  {Mcc: "297", Mnc: "01", Country: "ME", Operator: "One", HuaweiCarrier: 99},
+	"29702":  {Mcc: "297", Mnc: "02", Country: "ME", Operator: "Telekom", HuaweiCarrier: 99},
+	"29703":  {Mcc: "297", Mnc: "03", Country: "ME", Operator: "m:tel", HuaweiCarrier: 99},
+	"302220": {Mcc: "302", Mnc: "220", Country: "CA", Operator: "Telus", HuaweiCarrier: 99},
+	"302320": {Mcc: "302", Mnc: "320", Country: "CA", Operator: "Rogers", HuaweiCarrier: 99},
+	"302370": {Mcc: "302", Mnc: "370", Country: "CA", Operator: "Fido", HuaweiCarrier: 99},
+	"302490": {Mcc: "302", Mnc: "490", Country: "CA", Operator: "Freedom Mobile", HuaweiCarrier: 99},
+	"302500": {Mcc: "302", Mnc: "500", Country: "CA", Operator: "Videotron", HuaweiCarrier: 99},
+	"302610": {Mcc: "302", Mnc: "610", Country: "CA", Operator: "Bell", HuaweiCarrier: 99},
+	"302640": {Mcc: "302", Mnc: "640", Country: "CA", Operator: "Bell", HuaweiCarrier: 99},
+	"302653": {Mcc: "302", Mnc: "653", Country: "CA", Operator: "Telus", HuaweiCarrier: 99},
+	"302720": {Mcc: "302", Mnc: "720", Country: "CA", Operator: "Rogers", HuaweiCarrier: 99},
+	"302780": {Mcc: "302", Mnc: "780", Country: "CA", Operator: "SaskTel", HuaweiCarrier: 99},
+	"30801":  {Mcc: "308", Mnc: "01", Country: "PM", Operator: "Ameris", HuaweiCarrier: 99},
+	"310012": {Mcc: "310", Mnc: "012", Country: "US", Operator: "Verizon", HuaweiCarrier: 99},
+	"310030": {Mcc: "310", Mnc: "030", Country: "US", Operator: "AT&T", HuaweiCarrier: 99},
+	"310120": {Mcc: "310", Mnc: "120", Country: "US", Operator: "Sprint", HuaweiCarrier: 99},
+	"310150": {Mcc: "310", Mnc: "150", Country: "US", Operator: "AT&T", HuaweiCarrier: 99},
+	"310160": {Mcc: "310", Mnc: "160", Country: "US", Operator: "T-Mobile", HuaweiCarrier: 99},
+	"310170": {Mcc: "310", Mnc: "170", Country: "US", Operator: "AT&T", HuaweiCarrier: 99},
+	"310200": {Mcc: "310", Mnc: "200", Country: "US", Operator: "T-Mobile", HuaweiCarrier: 99},
+	"310210": {Mcc: "310", Mnc: "210", Country: "US", Operator: "T-Mobile", HuaweiCarrier: 99},
+	"310220": {Mcc: "310", Mnc: "220", Country: "US", Operator: "T-Mobile", HuaweiCarrier: 99},
+	"310230": {Mcc: "310", Mnc: "230", Country: "US", Operator: "T-Mobile", HuaweiCarrier: 99},
+	"310240": {Mcc: "310", Mnc: "240", Country: "US", Operator: "T-Mobile", HuaweiCarrier: 99},
+	"310250": {Mcc: "310", Mnc: "250", Country: "US", Operator: "T-Mobile", HuaweiCarrier: 99},
+	"310260": {Mcc: "310", Mnc: "260", Country: "US", Operator: "T-Mobile", HuaweiCarrier: 99},
+	"310270": {Mcc: "310", Mnc: "270", Country: "US", Operator: "T-Mobile", HuaweiCarrier: 99},
+	"310280": {Mcc: "310", Mnc: "280", Country: "US", Operator: "AT&T", HuaweiCarrier: 99},
+	"310310": {Mcc: "310", Mnc: "310", Country: "US", Operator: "T-Mobile", HuaweiCarrier: 99},
+	"310380": {Mcc: "310", Mnc: "380", Country: "US", Operator: "AT&T", HuaweiCarrier: 99},
+	"310410": {Mcc: "310", Mnc: "410", Country: "US", Operator: "AT&T", HuaweiCarrier: 99},
+	"310490": {Mcc: "310", Mnc: "490", Country: "US", Operator: "T-Mobile", HuaweiCarrier: 99},
+	"310560": {Mcc: "310", Mnc: "560", Country: "US", Operator: "AT&T", HuaweiCarrier: 99},
+	"310590": {Mcc: "310", Mnc: "590", Country: "US", Operator: "Verizon", HuaweiCarrier: 99},
+	"310660": {Mcc: "310", Mnc: "660", Country: "US", Operator: "T-Mobile", HuaweiCarrier: 99},
+	"310680": {Mcc: "310", Mnc: "680", Country: "US", Operator: "AT&T", HuaweiCarrier: 99},
+	"310890": {Mcc: "310", Mnc: "890", Country: "US", Operator: "Verizon", HuaweiCarrier: 99},
+	"310910": {Mcc: "310", Mnc: "910", Country: "US", Operator: "Verizon", HuaweiCarrier: 99},
+	"311110": {Mcc: "311", Mnc: "110", Country: "US", Operator: "Verizon", HuaweiCarrier: 99},
+	"311270": {Mcc: "311", Mnc: "270", Country: "US", Operator: "Verizon", HuaweiCarrier: 99},
+	"311390": {Mcc: "311", Mnc: "390", Country: "US", Operator: "Verizon", HuaweiCarrier: 99},
+	"311480": {Mcc: "311", Mnc: "480", Country: "US", Operator: "Verizon", HuaweiCarrier: 99},
+	"311481": {Mcc: "311", Mnc: "481", Country: "US", Operator: "Verizon", HuaweiCarrier: 99},
+	"311482": {Mcc: "311", Mnc: "482", Country: "US", Operator: "Verizon", HuaweiCarrier: 99},
+	"311489": {Mcc: "311", Mnc: "489", Country: "US", Operator: "Verizon", HuaweiCarrier: 99},
+	"311490": {Mcc: "311", Mnc: "490", Country: "US", Operator: "T-Mobile", HuaweiCarrier: 99},
+	"311870": {Mcc: "311", Mnc: "870", Country: "US", Operator: "Boost", HuaweiCarrier: 99},
+	"311880": {Mcc: "311", Mnc: "880", Country: "US", Operator: "Sprint", HuaweiCarrier: 99},
+	"312530": {Mcc: "312", Mnc: "530", Country: "US", Operator: "Sprint", HuaweiCarrier: 99},
+	"313100": {Mcc: "313", Mnc: "100", Country: "US", Operator: "FirstNet", HuaweiCarrier: 99},
+	"315010": {Mcc: "315", Mnc: "010", Country: "US", Operator: "CBRS", HuaweiCarrier: 99},
+	"316011": {Mcc: "316", Mnc: "011", Country: "US", Operator: "Southern Communications", HuaweiCarrier: 99},
+	"330110": {Mcc: "330", Mnc: "110", Country: "PR", Operator: "Claro", HuaweiCarrier: 99},
+	"330120": {Mcc: "330", Mnc: "120", Country: "PR", Operator: "Liberty", HuaweiCarrier: 99},
+	"33201":  {Mcc: "332", Mnc: "01", Country: "VI", Operator: "MTC", HuaweiCarrier: 99},
+	"334020": {Mcc: "334", Mnc: "020", Country: "MX", Operator: "Telcel", HuaweiCarrier: 99},
+	"334030": {Mcc: "334", Mnc: "030", Country: "MX", Operator: "Movistar", HuaweiCarrier: 99},
+	"334050": {Mcc: "334", Mnc: "050", Country: "MX", Operator: "AT&T", HuaweiCarrier: 99},
+	"334090": {Mcc: "334", Mnc: "090", Country: "MX", Operator: "AT&T", HuaweiCarrier: 99},
+	"334140": {Mcc: "334", Mnc: "140", Country: "MX", Operator: "Altan Redes", HuaweiCarrier: 99},
+	"338050": {Mcc: "338", Mnc: "050", Country: "JM", Operator: "Digicel", HuaweiCarrier: 99},
+	"338180": {Mcc: "338", Mnc: "180", Country: "JM", Operator: "Flow", HuaweiCarrier: 99},
+	"34001":  {Mcc: "340", Mnc: "01", Country: "GP", Operator: "Orange", HuaweiCarrier: 99},
+	"34020":  {Mcc: "340", Mnc: "20", Country: "GP", Operator: "Digicel", HuaweiCarrier: 99},
+	"342600": {Mcc: "342", Mnc: "600", Country: "BB", Operator: "Flow", HuaweiCarrier: 99},
+	"342750": {Mcc: "342", Mnc: "750", Country: "BB", Operator: "Digicel", HuaweiCarrier: 99},
+	"344030": {Mcc: "344", Mnc: "030", Country: "AG", Operator: "APUA", HuaweiCarrier: 99},
+	"344920": {Mcc: "344", Mnc: "920", Country: "AG", Operator: "Flow", HuaweiCarrier: 99},
+	"346140": {Mcc: "346", Mnc: "140", Country: "KY", Operator: "Flow", HuaweiCarrier: 99},
+	"348170": {Mcc: "348", Mnc: "170", Country: "VG", Operator: "Flow", HuaweiCarrier: 99},
+	"348570": {Mcc: "348", Mnc: "570", Country: "VG", Operator: "CCT", HuaweiCarrier: 99},
+	"35001":  {Mcc: "350", Mnc: "01", Country: "BM", Operator: "One", HuaweiCarrier: 99},
+	"35002":  {Mcc: "350", Mnc: "02", Country: "BM", Operator: "Mobility", HuaweiCarrier: 99},
+	"352030": {Mcc: "352", Mnc: "030", Country: "GD", Operator: "Digicel", HuaweiCarrier: 99},
+	"352110": {Mcc: "352", Mnc: "110", Country: "GD", Operator: "Flow", HuaweiCarrier: 99},
+	"354860": {Mcc: "354", Mnc: "860", Country: "MS", Operator: "Flow", HuaweiCarrier: 99},
+	"356050": {Mcc: "356", Mnc: "050", Country: "KN", Operator: "Digicel", HuaweiCarrier: 99},
+	"356110": {Mcc: "356", Mnc: "110", Country: "KN", Operator: "Flow", HuaweiCarrier: 99},
+	"358110": {Mcc: "358", Mnc: "110", Country: "LC", Operator: "Flow", HuaweiCarrier: 99},
+	"360110": {Mcc: "360", Mnc: "110", Country: "VC", Operator: "Flow", HuaweiCarrier: 99},
+	"36251":  {Mcc: "362", Mnc: "51", Country: "AI", Operator: "Telcell", HuaweiCarrier: 99},
+	"36269":  {Mcc: "362", Mnc: "69", Country: "AI", Operator: "Digicel", HuaweiCarrier: 99},
+	"36301":  {Mcc: "363", Mnc: "01", Country: "AW", Operator: "SETAR", HuaweiCarrier: 99},
+	"36302":  {Mcc: "363", Mnc: "02", Country: "AW", Operator: "Digicel", HuaweiCarrier: 99},
+	"36439":  {Mcc: "364", Mnc: "39", Country: "BS", Operator: "BTC", HuaweiCarrier: 99},
+	"36449":  {Mcc: "364", Mnc: "49", Country: "BS", Operator: "Aliv", HuaweiCarrier: 99},
+	"365010": {Mcc: "365", Mnc: "010", Country: "AI", Operator: "Weblinks", HuaweiCarrier: 99},
+	"365840": {Mcc: "365", Mnc: "840", Country: "AI", Operator: "Flow", HuaweiCarrier: 99},
+	"366020": {Mcc: "366", Mnc: "020", Country: "DM", Operator: "Digicel", HuaweiCarrier: 99},
+	"366110": {Mcc: "366", Mnc: "110", Country: "DM", Operator: "Flow", HuaweiCarrier: 99},
+	"36801":  {Mcc: "368", Mnc: "01", Country: "CU", Operator: "CUBACEL", HuaweiCarrier: 99},
+	"37001":  {Mcc: "370", Mnc: "01", Country: "DO", Operator: "Altice", HuaweiCarrier: 99},
+	"37002":  {Mcc: "370", Mnc: "02", Country: "DO", Operator: "Claro", HuaweiCarrier: 99},
+	"37004":  {Mcc: "370", Mnc: "04", Country: "DO", Operator: "Viva", HuaweiCarrier: 99},
+	"37202":  {Mcc: "372", Mnc: "02", Country: "HT", Operator: "Digicel", HuaweiCarrier: 99},
+	"37203":  {Mcc: "372", Mnc: "03", Country: "HT", Operator: "Natcom", HuaweiCarrier: 99},
+	"37412":  {Mcc: "374", Mnc: "12", Country: "TT", Operator: "bmobile", HuaweiCarrier: 99},
+	"374130": {Mcc: "374", Mnc: "130", Country: "TT", Operator: "Digicel", HuaweiCarrier: 99},
+	"376350": {Mcc: "376", Mnc: "350", Country: "TC", Operator: "Flow", HuaweiCarrier: 99},
+	"376352": {Mcc: "376", Mnc: "352", Country: "TC", Operator: "Flow", HuaweiCarrier: 99},
+	"40001":  {Mcc: "400", Mnc: "01", Country: "AZ", Operator: "Azercell", HuaweiCarrier: 99},
+	"40002":  {Mcc: "400", Mnc: "02", Country: "AZ", Operator: "Bakcell", HuaweiCarrier: 99},
+	"40004":  {Mcc: "400", Mnc: "04", Country: "AZ", Operator: "Nar", HuaweiCarrier: 99},
+	"40101":  {Mcc: "401", Mnc: "01", Country: "KZ", Operator: "Beeline", HuaweiCarrier: 99},
+	"40102":  {Mcc: "401", Mnc: "02", Country: "KZ", Operator: "Kcell", HuaweiCarrier: 99},
+	"40107":  {Mcc: "401", Mnc: "07", Country: "KZ", Operator: "Altel", HuaweiCarrier: 99},
+	"40108":  {Mcc: "401", Mnc: "08", Country: "KZ", Operator: "Kazakhtelecom", HuaweiCarrier: 99},
+	"40177":  {Mcc: "401", Mnc: "77", Country: "KZ", Operator: "Tele2", HuaweiCarrier: 99},
+	"40211":  {Mcc: "402", Mnc: "11", Country: "BT", Operator: "B-Mobile", HuaweiCarrier: 99},
+	"40277":  {Mcc: "402", Mnc: "77", Country: "BT", Operator: "TashiCell", HuaweiCarrier: 99},
+	"40401":  {Mcc: "404", Mnc: "01", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"40402":  {Mcc: "404", Mnc: "02", Country: "IN", Operator: "Airtel", HuaweiCarrier: 99},
+	"40403":  {Mcc: "404", Mnc: "03", Country: "IN", Operator: "Airtel", HuaweiCarrier: 99},
+	"40404":  {Mcc: "404", Mnc: "04", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"40405":  {Mcc: "404", Mnc: "05", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"40407":  {Mcc: "404", Mnc: "07", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"40410":  {Mcc: "404", Mnc: "10", Country: "IN", Operator: "Airtel", HuaweiCarrier: 99},
+	"40411":  {Mcc: "404", Mnc: "11", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"40412":  {Mcc: "404", Mnc: "12", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"40413":  {Mcc: "404", Mnc: "13", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"40414":  {Mcc: "404", Mnc: "14", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"40415":  {Mcc: "404", Mnc: "15", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"40416":  {Mcc: "404", Mnc: "16", Country: "IN", Operator: "Airtel", HuaweiCarrier: 99},
+	"40419":  {Mcc: "404", Mnc: "19", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"40420":  {Mcc: "404", Mnc: "20", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"40422":  {Mcc: "404", Mnc: "22", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"40424":  {Mcc: "404", Mnc: "24", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"40427":  {Mcc: "404", Mnc: "27", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"40430":  {Mcc: "404", Mnc: "30", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"40431":  {Mcc: "404", Mnc: "31", Country: "IN", Operator: "Airtel", HuaweiCarrier: 99},
+	"40434":  {Mcc: "404", Mnc: "34", Country: "IN", Operator: "BSNL", HuaweiCarrier: 99},
+	"40438":  {Mcc: "404", Mnc: "38", Country: "IN", Operator: "BSNL", HuaweiCarrier: 99},
+	"40440":  {Mcc: "404", Mnc: "40", Country: "IN", Operator: "Airtel", HuaweiCarrier: 99},
+	"40443":  {Mcc: "404", Mnc: "43", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"40444":  {Mcc: "404", Mnc: "44", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"40445":  {Mcc: "404", Mnc: "45", Country: "IN", Operator: "Airtel", HuaweiCarrier: 99},
+	"40446":  {Mcc: "404", Mnc: "46", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"40449":  {Mcc: "404", Mnc: "49", Country: "IN", Operator: "Airtel", HuaweiCarrier: 99},
+	"40451":  {Mcc: "404", Mnc: "51", Country: "IN", Operator: "BSNL", HuaweiCarrier: 99},
+	"40453":  {Mcc: "404", Mnc: "53", Country: "IN", Operator: "BSNL", HuaweiCarrier: 99},
+	"40454":  {Mcc: "404", Mnc: "54", Country: "IN", Operator: "BSNL", HuaweiCarrier: 99},
+	"40455":  {Mcc: "404", Mnc: "55", Country: "IN", Operator: "BSNL", HuaweiCarrier: 99},
+	"40456":  {Mcc: "404", Mnc: "56", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"40457":  {Mcc: "404", Mnc: "57", Country: "IN", Operator: "BSNL", HuaweiCarrier: 99},
+	"40458":  {Mcc: "404", Mnc: "58", Country: "IN", Operator: "BSNL", HuaweiCarrier: 99},
+	"40459":  {Mcc: "404", Mnc: "59", Country: "IN", Operator: "BSNL", HuaweiCarrier: 99},
+	"40460":  {Mcc: "404", Mnc: "60", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"40462":  {Mcc: "404", Mnc: "62", Country: "IN", Operator: "BSNL", HuaweiCarrier: 99},
+	"40464":  {Mcc: "404", Mnc: "64", Country: "IN", Operator: "BSNL", HuaweiCarrier: 99},
+	"40466":  {Mcc: "404", Mnc: "66", Country: "IN", Operator: "BSNL", HuaweiCarrier: 99},
+	"40468":  {Mcc: "404", Mnc: "68", Country: "IN", Operator: "MTNL", HuaweiCarrier: 99},
+	"40469":  {Mcc: "404", Mnc: "69", Country: "IN", Operator: "MTNL", HuaweiCarrier: 99},
+	"40470":  {Mcc: "404", Mnc: "70", Country: "IN", Operator: "Airtel", HuaweiCarrier: 99},
+	"40471":  {Mcc: "404", Mnc: "71", Country: "IN", Operator: "BSNL", HuaweiCarrier: 99},
+	"40472":  {Mcc: "404", Mnc: "72", Country: "IN", Operator: "BSNL", HuaweiCarrier: 99},
+	"40473":  {Mcc: "404", Mnc: "73", Country: "IN", Operator: "BSNL", HuaweiCarrier: 99},
+	"40474":  {Mcc: "404", Mnc: "74", Country: "IN", Operator: "BSNL", HuaweiCarrier: 99},
+	"40475":  {Mcc: "404", Mnc: "75", Country: "IN", Operator: "BSNL", HuaweiCarrier: 99},
+	"40476":  {Mcc: "404", Mnc: "76", Country: "IN", Operator: "BSNL", HuaweiCarrier: 99},
+	"40477":  {Mcc: "404", Mnc: "77", Country: "IN", Operator: "BSNL", HuaweiCarrier: 99},
+	"40478":  {Mcc: "404", Mnc: "78", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"40479":  {Mcc: "404", Mnc: "79", Country: "IN", Operator: "BSNL", HuaweiCarrier: 99},
+	"40480":  {Mcc: "404", Mnc: "80", Country: "IN", Operator: "BSNL", HuaweiCarrier: 99},
+	"40481":  {Mcc: "404", Mnc: "81", Country: "IN", Operator: "BSNL", HuaweiCarrier: 99},
+	"40482":  {Mcc: "404", Mnc: "82", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"40484":  {Mcc: "404", Mnc: "84", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"40486":  {Mcc: "404", Mnc: "86", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"40487":  {Mcc: "404", Mnc: "87", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"40488":  {Mcc: "404", Mnc: "88", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"40490":  {Mcc: "404", Mnc: "90", Country: "IN", Operator: "Airtel", HuaweiCarrier: 99},
+	"40492":  {Mcc: "404", Mnc: "92", Country: "IN", Operator: "Airtel", HuaweiCarrier: 99},
+	"40493":  {Mcc: "404", Mnc: "93", Country: "IN", Operator: "Airtel", HuaweiCarrier: 99},
+	"40494":  {Mcc: "404", Mnc: "94", Country: "IN", Operator: "Airtel", HuaweiCarrier: 99},
+	"40495":  {Mcc: "404", Mnc: "95", Country: "IN", Operator: "Airtel", HuaweiCarrier: 99},
+	"40496":  {Mcc: "404", Mnc: "96", Country: "IN", Operator: "Airtel", HuaweiCarrier: 99},
+	"40497":  {Mcc: "404", Mnc: "97", Country: "IN", Operator: "Airtel", HuaweiCarrier: 99},
+	"40498":  {Mcc: "404", Mnc: "98", Country: "IN", Operator: "Airtel", HuaweiCarrier: 99},
+	"40501":  {Mcc: "405", Mnc: "01", Country: "IN", Operator: "Reliance", HuaweiCarrier: 99},
+	"405025": {Mcc: "405", Mnc: "025", Country: "IN", Operator: "Tata Docomo", HuaweiCarrier: 99},
+	"40551":  {Mcc: "405", Mnc: "51", Country: "IN", Operator: "Airtel", HuaweiCarrier: 99},
+	"40552":  {Mcc: "405", Mnc: "52", Country: "IN", Operator: "Airtel", HuaweiCarrier: 99},
+	"40553":  {Mcc: "405", Mnc: "53", Country: "IN", Operator: "Airtel", HuaweiCarrier: 99},
+	"40554":  {Mcc: "405", Mnc: "54", Country: "IN", Operator: "Airtel", HuaweiCarrier: 99},
+	"40555":  {Mcc: "405", Mnc: "55", Country: "IN", Operator: "Airtel", HuaweiCarrier: 99},
+	"40556":  {Mcc: "405", Mnc: "56", Country: "IN", Operator: "Airtel", HuaweiCarrier: 99},
+	"405751": {Mcc: "405", Mnc: "751", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"405752": {Mcc: "405", Mnc: "752", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"405753": {Mcc: "405", Mnc: "753", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"405754": {Mcc: "405", Mnc: "754", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"405755": {Mcc: "405", Mnc: "755", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"405756": {Mcc: "405", Mnc: "756", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"405799": {Mcc: "405", Mnc: "799", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"405840": {Mcc: "405", Mnc: "840", Country: "IN", Operator: "Jio", HuaweiCarrier: 99},
+	"405845": {Mcc: "405", Mnc: "845", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"405846": {Mcc: "405", Mnc: "846", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"405848": {Mcc: "405", Mnc: "848", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"405849": {Mcc: "405", Mnc: "849", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"405850": {Mcc: "405", Mnc: "850", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"405852": {Mcc: "405", Mnc: "852", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"405853": {Mcc: "405", Mnc: "853", Country: "IN", Operator: "Vodafone Idea", HuaweiCarrier: 99},
+	"405854": {Mcc: "405", Mnc: "854", Country: "IN", Operator: "Jio", HuaweiCarrier: 99},
+	"405855": {Mcc: "405", Mnc: "855", Country: "IN", Operator: "Jio", HuaweiCarrier: 99},
+	"405856": {Mcc: "405", Mnc: "856", Country: "IN", Operator: "Jio", HuaweiCarrier: 99},
+	"405857": {Mcc: "405", Mnc: "857", Country: "IN", Operator: "Jio", HuaweiCarrier: 99},
+	"405858": {Mcc: "405", Mnc: "858", Country: "IN", Operator: "Jio", HuaweiCarrier: 99},
+	"405859": {Mcc: "405", Mnc: "859", Country: "IN", Operator: "Jio", HuaweiCarrier: 99},
+	"405860": {Mcc: "405", Mnc: "860", Country: "IN", Operator: "Jio", HuaweiCarrier: 99},
+	"405861": {Mcc: "405", Mnc: "861", Country: "IN", Operator: "Jio", HuaweiCarrier: 99},
+	"405862": {Mcc: "405", Mnc: "862", Country: "IN", Operator: "Jio", HuaweiCarrier: 99},
+	"405863": {Mcc: "405", Mnc: "863", Country: "IN", Operator: "Jio", HuaweiCarrier: 99},
+	"405864": {Mcc: "405", Mnc: "864", Country: "IN", Operator: "Jio", HuaweiCarrier: 99},
+	"405865": {Mcc: "405", Mnc: "865", Country: "IN", Operator: "Jio", HuaweiCarrier: 99},
+	"405866": {Mcc: "405", Mnc: "866", Country: "IN", Operator: "Jio", HuaweiCarrier: 99},
+	"405867": {Mcc: "405", Mnc: "867", Country: "IN", Operator: "Jio", HuaweiCarrier: 99},
+	"405868": {Mcc: "405", Mnc: "868", Country: "IN", Operator: "Jio", HuaweiCarrier: 99},
+	"405869": {Mcc: "405", Mnc: "869", Country: "IN", Operator: "Jio", HuaweiCarrier: 99},
+	"405870": {Mcc: "405", Mnc: "870", Country: "IN", Operator: "Jio", HuaweiCarrier: 99},
+	"405871": {Mcc: "405", Mnc: "871", Country: "IN", Operator: "Jio", HuaweiCarrier: 99},
+	"405872": {Mcc: "405", Mnc: "872", Country: "IN", Operator: "Jio", HuaweiCarrier: 99},
+	"405873": {Mcc: "405", Mnc: "873", Country: "IN", Operator: "Jio", HuaweiCarrier: 99},
+	"405874": {Mcc: "405", Mnc: "874", Country: "IN", Operator: "Jio", HuaweiCarrier: 99},
+	"41001":  {Mcc: "410", Mnc: "01", Country: "PK", Operator: "Jazz", HuaweiCarrier: 99},
+	"41003":  {Mcc: "410", Mnc: "03", Country: "PK", Operator: "Ufone", HuaweiCarrier: 99},
+	"41004":  {Mcc: "410", Mnc: "04", Country: "PK", Operator: "Zong", HuaweiCarrier: 99},
+	"41005":  {Mcc: "410", Mnc: "05", Country: "PK", Operator: "SCO", HuaweiCarrier: 99},
+	"41006":  {Mcc: "410", Mnc: "06", Country: "PK", Operator: "Telenor", HuaweiCarrier: 99},
+	"41007":  {Mcc: "410", Mnc: "07", Country: "PK", Operator: "Jazz", HuaweiCarrier: 99},
+	"41201":  {Mcc: "412", Mnc: "01", Country: "AF", Operator: "AWCC", HuaweiCarrier: 99},
+	"41220":  {Mcc: "412", Mnc: "20", Country: "AF", Operator: "Roshan", HuaweiCarrier: 99},
+	"41240":  {Mcc: "412", Mnc: "40", Country: "AF", Operator: "MTN", HuaweiCarrier: 99},
+	"41250":  {Mcc: "412", Mnc: "50", Country: "AF", Operator: "Etisalat", HuaweiCarrier: 99},
+	"41301":  {Mcc: "413", Mnc: "01", Country: "LK", Operator: "Mobitel", HuaweiCarrier: 99},
+	"41302":  {Mcc: "413", Mnc: "02", Country: "LK", Operator: "Dialog", HuaweiCarrier: 99},
+	"41303":  {Mcc: "413", Mnc: "03", Country: "LK", Operator: "Etisalat", HuaweiCarrier: 99},
+	"41305":  {Mcc: "413", Mnc: "05", Country: "LK", Operator: "Airtel", HuaweiCarrier: 99},
+	"41308":  {Mcc: "413", Mnc: "08", Country: "LK", Operator: "Hutch", HuaweiCarrier: 99},
+	"41401":  {Mcc: "414", Mnc: "01", Country: "MM", Operator: "MPT", HuaweiCarrier: 99},
+	"41405":  {Mcc: "414", Mnc: "05", Country: "MM", Operator: "Ooredoo", HuaweiCarrier: 99},
+	"41406":  {Mcc: "414", Mnc: "06", Country: "MM", Operator: "ATOM", HuaweiCarrier: 99},
+	"41409":  {Mcc: "414", Mnc: "09", Country: "MM", Operator: "Mytel", HuaweiCarrier: 99},
+	"41501":  {Mcc: "415", Mnc: "01", Country: "LB", Operator: "Alfa", HuaweiCarrier: 99},
+	"41503":  {Mcc: "415", Mnc: "03", Country: "LB", Operator: "touch", HuaweiCarrier: 99},
+	"41601":  {Mcc: "416", Mnc: "01", Country: "JO", Operator: "Zain", HuaweiCarrier: 99},
+	"41603":  {Mcc: "416", Mnc: "03", Country: "JO", Operator: "Umniah", HuaweiCarrier: 99},
+	"41677":  {Mcc: "416", Mnc: "77", Country: "JO", Operator: "Orange", HuaweiCarrier: 99},
+	"41701":  {Mcc: "417", Mnc: "01", Country: "SY", Operator: "Syriatel", HuaweiCarrier: 99},
+	"41702":  {Mcc: "417", Mnc: "02", Country: "SY", Operator: "MTN", HuaweiCarrier: 99},
+	"41805":  {Mcc: "418", Mnc: "05", Country: "IQ", Operator: "Asiacell", HuaweiCarrier: 99},
+	"41820":  {Mcc: "418", Mnc: "20", Country: "IQ", Operator: "Zain", HuaweiCarrier: 99},
+	"41840":  {Mcc: "418", Mnc: "40", Country: "IQ", Operator: "Korek", HuaweiCarrier: 99},
+	"41902":  {Mcc: "419", Mnc: "02", Country: "KW", Operator: "Zain", HuaweiCarrier: 99},
+	"41903":  {Mcc: "419", Mnc: "03", Country: "KW", Operator: "Ooredoo", HuaweiCarrier: 99},
+	"41904":  {Mcc: "419", Mnc: "04", Country: "KW", Operator: "stc", HuaweiCarrier: 99},
+	"42001":  {Mcc: "420", Mnc: "01", Country: "SA", Operator: "STC", HuaweiCarrier: 99},
+	"42003":  {Mcc: "420", Mnc: "03", Country: "SA", Operator: "Mobily", HuaweiCarrier: 99},
+	"42004":  {Mcc: "420", Mnc: "04", Country: "SA", Operator: "Zain", HuaweiCarrier: 99},
+	"42005":  {Mcc: "420", Mnc: "05", Country: "SA", Operator: "Virgin Mobile", HuaweiCarrier: 99},
+	"42101":  {Mcc: "421", Mnc: "01", Country: "YE", Operator: "SabaFon", HuaweiCarrier: 99},
+	"42102":  {Mcc: "421", Mnc: "02", Country: "YE", Operator: "MTN", HuaweiCarrier: 99},
+	"42103":  {Mcc: "421", Mnc: "03", Country: "YE", Operator: "Yemen Mobile", HuaweiCarrier: 99},
+	"42202":  {Mcc: "422", Mnc: "02", Country: "OM", Operator: "Omantel", HuaweiCarrier: 99},
+	"42203":  {Mcc: "422", Mnc: "03", Country: "OM", Operator: "Ooredoo", HuaweiCarrier: 99},
+	"42204":  {Mcc: "422", Mnc: "04", Country: "OM", Operator: "Vodafone", HuaweiCarrier: 99},
+	"42402":  {Mcc: "424", Mnc: "02", Country: "AE", Operator: "Etisalat", HuaweiCarrier: 99},
+	"42403":  {Mcc: "424", Mnc: "03", Country: "AE", Operator: "du", HuaweiCarrier: 99},
+	"42501":  {Mcc: "425", Mnc: "01", Country: "IL", Operator: "Partner", HuaweiCarrier: 99},
+	"42502":  {Mcc: "425", Mnc: "02", Country: "IL", Operator: "Cellcom", HuaweiCarrier: 99},
+	"42503":  {Mcc: "425", Mnc: "03", Country: "IL", Operator: "Pelephone", HuaweiCarrier: 99},
+	"42506":  {Mcc: "425", Mnc: "06", Country: "IL", Operator: "Pelephone", HuaweiCarrier: 99},
+	"42507":  {Mcc: "425", Mnc: "07", Country: "IL", Operator: "Hot Mobile", HuaweiCarrier: 99},
+	"42508":  {Mcc: "425", Mnc: "08", Country: "IL", Operator: "Golan Telecom", HuaweiCarrier: 99},
+	"42512":  {Mcc: "425", Mnc: "12", Country: "IL", Operator: "Cellcom", HuaweiCarrier: 99},
+	"42601":  {Mcc: "426", Mnc: "01", Country: "BH", Operator: "Batelco", HuaweiCarrier: 99},
+	"42602":  {Mcc: "426", Mnc: "02", Country: "BH", Operator: "Zain", HuaweiCarrier: 99},
+	"42604":  {Mcc: "426", Mnc: "04", Country: "BH", Operator: "stc", HuaweiCarrier: 99},
+	"42701":  {Mcc: "427", Mnc: "01", Country: "QA", Operator: "Ooredoo", HuaweiCarrier: 99},
+	"42702":  {Mcc: "427", Mnc: "02", Country: "QA", Operator: "Vodafone", HuaweiCarrier: 99},
+	"42888":  {Mcc: "428", Mnc: "88", Country: "MN", Operator: "Unitel", HuaweiCarrier: 99},
+	"42891":  {Mcc: "428", Mnc: "91", Country: "MN", Operator: "Skytel", HuaweiCarrier: 99},
+	"42898":  {Mcc: "428", Mnc: "98", Country: "MN", Operator: "G-Mobile", HuaweiCarrier: 99},
+	"42899":  {Mcc: "428", Mnc: "99", Country: "MN", Operator: "Mobicom", HuaweiCarrier: 99},
+	"42901":  {Mcc: "429", Mnc: "01", Country: "NP", Operator: "Nepal Telecom", HuaweiCarrier: 99},
+	"42902":  {Mcc: "429", Mnc: "02", Country: "NP", Operator: "Ncell", HuaweiCarrier: 99},
+	"43211":  {Mcc: "432", Mnc: "11", Country: "IR", Operator: "MCI", HuaweiCarrier: 99},
+	"43220":  {Mcc: "432", Mnc: "20", Country: "IR", Operator: "RighTel", HuaweiCarrier: 99},
+	"43235":  {Mcc: "432", Mnc: "35", Country: "IR", Operator: "Irancell", HuaweiCarrier: 99},
+	"43404":  {Mcc: "434", Mnc: "04", Country: "UZ", Operator: "Beeline", HuaweiCarrier: 99},
+	"43405":  {Mcc: "434", Mnc: "05", Country: "UZ", Operator: "Ucell", HuaweiCarrier: 99},
+	"43407":  {Mcc: "434", Mnc: "07", Country: "UZ", Operator: "Mobiuz", HuaweiCarrier: 99},
+	"43601":  {Mcc: "436", Mnc: "01", Country: "TJ", Operator: "Tcell", HuaweiCarrier: 99},
+	"43602":  {Mcc: "436", Mnc: "02", Country: "TJ", Operator: "Tcell", HuaweiCarrier: 99},
+	"43603":  {Mcc: "436", Mnc: "03", Country: "TJ", Operator: "MegaFon", HuaweiCarrier: 99},
+	"43604":  {Mcc: "436", Mnc: "04", Country: "TJ", Operator: "Babilon-M", HuaweiCarrier: 99},
+	"43701":  {Mcc: "437", Mnc: "01", Country: "KG", Operator: "Beeline", HuaweiCarrier: 99},
+	"43705":  {Mcc: "437", Mnc: "05", Country: "KG", Operator: "MegaCom", HuaweiCarrier: 99},
+	"43709":  {Mcc: "437", Mnc: "09", Country: "KG", Operator: "O!", HuaweiCarrier: 99},
+	"43801":  {Mcc: "438", Mnc: "01", Country: "TM", Operator: "MTS", HuaweiCarrier: 99},
+	"43802":  {Mcc: "438", Mnc: "02", Country: "TM", Operator: "TM CELL", HuaweiCarrier: 99},
+	"44000":  {Mcc: "440", Mnc: "00", Country: "JP", Operator: "Y!Mobile", HuaweiCarrier: 99},
+	"44010":  {Mcc: "440", Mnc: "10", Country: "JP", Operator: "NTT docomo", HuaweiCarrier: 99},
+	"44011":  {Mcc: "440", Mnc: "11", Country: "JP", Operator: "Rakuten Mobile", HuaweiCarrier: 99},
+	"44020":  {Mcc: "440", Mnc: "20", Country: "JP", Operator: "SoftBank", HuaweiCarrier: 99},
+	"44050":  {Mcc: "440", Mnc: "50", Country: "JP", Operator: "au", HuaweiCarrier: 99},
+	"44051":  {Mcc: "440", Mnc: "51", Country: "JP", Operator: "au", HuaweiCarrier: 99},
+	"44053":  {Mcc: "440", Mnc: "53", Country: "JP", Operator: "au", HuaweiCarrier: 99},
+	"44054":  {Mcc: "440", Mnc: "54", Country: "JP", Operator: "au", HuaweiCarrier: 99},
+	"44070":  {Mcc: "440", Mnc: "70", Country: "JP", Operator: "au", HuaweiCarrier: 99},
+	"441200": {Mcc: "441", Mnc: "200", Country: "JP", Operator: "SoftBank", HuaweiCarrier: 99},
+	"45002":  {Mcc: "450", Mnc: "02", Country: "KR", Operator: "KT", HuaweiCarrier: 99},
+	"45003":  {Mcc: "450", Mnc: "03", Country: "KR", Operator: "SK Telecom", HuaweiCarrier: 99},
+	"45004":  {Mcc: "450", Mnc: "04", Country: "KR", Operator: "KT", HuaweiCarrier: 99},
+	"45005":  {Mcc: "450", Mnc: "05", Country: "KR", Operator: "SK Telecom", HuaweiCarrier: 99},
+	"45006":  {Mcc: "450", Mnc: "06", Country: "KR", Operator: "LG U+", HuaweiCarrier: 99},
+	"45008":  {Mcc: "450", Mnc: "08", Country: "KR", Operator: "KT", HuaweiCarrier: 99},
+	"45011":  {Mcc: "450", Mnc: "11", Country: "KR", Operator: "SK Telecom", HuaweiCarrier: 99},
+	"45201":  {Mcc: "452", Mnc: "01", Country: "VN", Operator: "MobiFone", HuaweiCarrier: 99},
+	"45202":  {Mcc: "452", Mnc: "02", Country: "VN", Operator: "Vinaphone", HuaweiCarrier: 99},
+	"45204":  {Mcc: "452", Mnc: "04", Country: "VN", Operator: "Viettel", HuaweiCarrier: 99},
+	"45205":  {Mcc: "452", Mnc: "05", Country: "VN", Operator: "Vietnamobile", HuaweiCarrier: 99},
+	"45207":  {Mcc: "452", Mnc: "07", Country: "VN", Operator: "Gmobile", HuaweiCarrier: 99},
+	"45208":  {Mcc: "452", Mnc: "08", Country: "VN", Operator: "Viettel", HuaweiCarrier: 99},
+	"45400":  {Mcc: "454", Mnc: "00", Country: "HK", Operator: "CSL", HuaweiCarrier: 99},
+	"45401":  {Mcc: "454", Mnc: "01", Country: "HK", Operator: "CITIC Telecom 1616", HuaweiCarrier: 99},
+	"45402":  {Mcc: "454", Mnc: "02", Country: "HK", Operator: "CSL", HuaweiCarrier: 99},
+	"45403":  {Mcc: "454", Mnc: "03", Country: "HK", Operator: "3", HuaweiCarrier: 99},
+	"45404":  {Mcc: "454", Mnc: "04", Country: "HK", Operator: "3", HuaweiCarrier: 99},
+	"45406":  {Mcc: "454", Mnc: "06", Country: "HK", Operator: "SmarTone", HuaweiCarrier: 99},
+	"45407":  {Mcc: "454", Mnc: "07", Country: "HK", Operator: "China Unicom Hong Kong", HuaweiCarrier: 99},
+	"45410":  {Mcc: "454", Mnc: "10", Country: "HK", Operator: "CSL", HuaweiCarrier: 99},
+	"45412":  {Mcc: "454", Mnc: "12", Country: "HK", Operator: "China Mobile Hong Kong", HuaweiCarrier: 99},
+	"45413":  {Mcc: "454", Mnc: "13", Country: "HK", Operator: "China Mobile Hong Kong", HuaweiCarrier: 99},
+	"45415":  {Mcc: "454", Mnc: "15", Country: "HK", Operator: "SmarTone", HuaweiCarrier: 99},
+	"45416":  {Mcc: "454", Mnc: "16", Country: "HK", Operator: "PCCW Mobile", HuaweiCarrier: 99},
+	"45419":  {Mcc: "454", Mnc: "19", Country: "HK", Operator: "PCCW Mobile", HuaweiCarrier: 99},
+	"45429":  {Mcc: "454", Mnc: "29", Country: "HK", Operator: "PCCW Mobile", HuaweiCarrier: 99},
+	"45500":  {Mcc: "455", Mnc: "00", Country: "MO", Operator: "SmarTone", HuaweiCarrier: 99},
+	"45501":  {Mcc: "455", Mnc: "01", Country: "MO", Operator: "CTM", HuaweiCarrier: 99},
+	"45502":  {Mcc: "455", Mnc: "02", Country: "MO", Operator: "China Telecom Macau", HuaweiCarrier: 99},
+	"45503":  {Mcc: "455", Mnc: "03", Country: "MO", Operator: "3", HuaweiCarrier: 99},
+	"45504":  {Mcc: "455", Mnc: "04", Country: "MO", Operator: "CTM", HuaweiCarrier: 99},
+	"45505":  {Mcc: "455", Mnc: "05", Country: "MO", Operator: "3", HuaweiCarrier: 99},
+	"45507":  {Mcc: "455", Mnc: "07", Country: "MO", Operator: "China Telecom Macau", HuaweiCarrier: 99},
+	"45601":  {Mcc: "456", Mnc: "01", Country: "KH", Operator: "Cellcard", HuaweiCarrier: 99},
+	"45602":  {Mcc: "456", Mnc: "02", Country: "KH", Operator: "Smart", HuaweiCarrier: 99},
+	"45606":  {Mcc: "456", Mnc: "06", Country: "KH", Operator: "Smart", HuaweiCarrier: 99},
+	"45608":  {Mcc: "456", Mnc: "08", Country: "KH", Operator: "Metfone", HuaweiCarrier: 99},
+	"45701":  {Mcc: "457", Mnc: "01", Country: "LA", Operator: "LaoTel", HuaweiCarrier: 99},
+	"45702":  {Mcc: "457", Mnc: "02", Country: "LA", Operator: "ETL", HuaweiCarrier: 99},
+	"45703":  {Mcc: "457", Mnc: "03", Country: "LA", Operator: "Unitel", HuaweiCarrier: 99},
+	"45708":  {Mcc: "457", Mnc: "08", Country: "LA", Operator: "Beeline", HuaweiCarrier: 99},
+	"46000":  {Mcc: "460", Mnc: "00", Country: "CN", Operator: "China Mobile", HuaweiCarrier: 2},
+	"46001":  {Mcc: "460", Mnc: "01", Country: "CN", Operator: "China Unicom", HuaweiCarrier: 1},
+	"46002":  {Mcc: "460", Mnc: "02", Country: "CN", Operator: "China Mobile", HuaweiCarrier: 2},
+	"46003":  {Mcc: "460", Mnc: "03", Country: "CN", Operator: "China Telecom", HuaweiCarrier: 3},
+	"46004":  {Mcc: "460", Mnc: "04", Country: "CN", Operator: "China Mobile", HuaweiCarrier: 2},
+	"46005":  {Mcc: "460", Mnc: "05", Country: "CN", Operator: "China Telecom", HuaweiCarrier: 3},
+	"46006":  {Mcc: "460", Mnc: "06", Country: "CN", Operator: "China Unicom", HuaweiCarrier: 1},
+	"46007":  {Mcc: "460", Mnc: "07", Country: "CN", Operator: "China Mobile", HuaweiCarrier: 2},
+	"46008":  {Mcc: "460", Mnc: "08", Country: "CN", Operator: "China Mobile", HuaweiCarrier: 2},
+	"46009":  {Mcc: "460", Mnc: "09", Country: "CN", Operator: "China Unicom", HuaweiCarrier: 1},
+	"46011":  {Mcc: "460", Mnc: "11", Country: "CN", Operator: "China Telecom", HuaweiCarrier: 3},
+	"46012":  {Mcc: "460", Mnc: "12", Country: "CN", Operator: "China Telecom", HuaweiCarrier: 3},
+	"46013":  {Mcc: "460", Mnc: "13", Country: "CN", Operator: "China Mobile", HuaweiCarrier: 2},
+	"46015":  {Mcc: "460", Mnc: "15", Country: "CN", Operator: "China Broadnet", HuaweiCarrier: 99},
+	"46601":  {Mcc: "466", Mnc: "01", Country: "TW", Operator: "Far EasTone", HuaweiCarrier: 99},
+	"46605":  {Mcc: "466", Mnc: "05", Country: "TW", Operator: "Asia Pacific Telecom", HuaweiCarrier: 99},
+	"46611":  {Mcc: "466", Mnc: "11", Country: "TW", Operator: "Chunghwa Telecom", HuaweiCarrier: 99},
+	"46689":  {Mcc: "466", Mnc: "89", Country: "TW", Operator: "T Star", HuaweiCarrier: 99},
+	"46692":  {Mcc: "466", Mnc: "92", Country: "TW", Operator: "Chunghwa Telecom", HuaweiCarrier: 99},
+	"46693":  {Mcc: "466", Mnc: "93", Country: "TW", Operator: "Taiwan Mobile", HuaweiCarrier: 99},
+	"46697":  {Mcc: "466", Mnc: "97", Country: "TW", Operator: "Taiwan Mobile", HuaweiCarrier: 99},
+	"46699":  {Mcc: "466", Mnc: "99", Country: "TW", Operator: "Taiwan Mobile", HuaweiCarrier: 99},
+	"46705":  {Mcc: "467", Mnc: "05", Country: "KP", Operator: "Koryolink", HuaweiCarrier: 99},
+	"46706":  {Mcc: "467", Mnc: "06", Country: "KP", Operator: "Kangsong", HuaweiCarrier: 99},
+	"47001":  {Mcc: "470", Mnc: "01", Country: "BD", Operator: "Grameenphone", HuaweiCarrier: 99},
+	"47002":  {Mcc: "470", Mnc: "02", Country: "BD", Operator: "Robi", HuaweiCarrier: 99},
+	"47003":  {Mcc: "470", Mnc: "03", Country: "BD", Operator: "Banglalink", HuaweiCarrier: 99},
+	"47004":  {Mcc: "470", Mnc: "04", Country: "BD", Operator: "Teletalk", HuaweiCarrier: 99},
+	"47007":  {Mcc: "470", Mnc: "07", Country: "BD", Operator: "Airtel", HuaweiCarrier: 99},
+	"47201":  {Mcc: "472", Mnc: "01", Country: "MV", Operator: "Dhiraagu", HuaweiCarrier: 99},
+	"47202":  {Mcc: "472", Mnc: "02", Country: "MV", Operator: "Ooredoo", HuaweiCarrier: 99},
+	"50212":  {Mcc: "502", Mnc: "12", Country: "MY", Operator: "Maxis", HuaweiCarrier: 99},
+	"50213":  {Mcc: "502", Mnc: "13", Country: "MY", Operator: "CelcomDigi", HuaweiCarrier: 99},
+	"50216":  {Mcc: "502", Mnc: "16", Country: "MY", Operator: "DiGi", HuaweiCarrier: 99},
+	"50217":  {Mcc: "502", Mnc: "17", Country: "MY", Operator: "Maxis", HuaweiCarrier: 99},
+	"50218":  {Mcc: "502", Mnc: "18", Country: "MY", Operator: "U Mobile", HuaweiCarrier: 99},
+	"50219":  {Mcc: "502", Mnc: "19", Country: "MY", Operator: "CelcomDigi", HuaweiCarrier: 99},
+	"50501":  {Mcc: "505", Mnc: "01", Country: "AU", Operator: "Telstra", HuaweiCarrier: 99},
+	"50502":  {Mcc: "505", Mnc: "02", Country: "AU", Operator: "Optus", HuaweiCarrier: 99},
+	"50503":  {Mcc: "505", Mnc: "03", Country: "AU", Operator: "Vodafone", HuaweiCarrier: 99},
+	"50506":  {Mcc: "505", Mnc: "06", Country: "AU", Operator: "Vodafone", HuaweiCarrier: 99},
+	"50512":  {Mcc: "505", Mnc: "12", Country: "AU", Operator: "Vodafone", HuaweiCarrier: 99},
+	"50590":  {Mcc: "505", Mnc: "90", Country: "AU", Operator: "Optus", HuaweiCarrier: 99},
+	"51001":  {Mcc: "510", Mnc: "01", Country: "ID", Operator: "Indosat Ooredoo Hutchison", HuaweiCarrier: 99},
+	"51008":  {Mcc: "510", Mnc: "08", Country: "ID", Operator: "AXIS", HuaweiCarrier: 99},
+	"51009":  {Mcc: "510", Mnc: "09", Country: "ID", Operator: "Smartfren", HuaweiCarrier: 99},
+	"51010":  {Mcc: "510", Mnc: "10", Country: "ID", Operator: "Telkomsel", HuaweiCarrier: 99},
+	"51011":  {Mcc: "510", Mnc: "11", Country: "ID", Operator: "XL Axiata", HuaweiCarrier: 99},
+	"51021":  {Mcc: "510", Mnc: "21", Country: "ID", Operator: "IM3", HuaweiCarrier: 99},
+	"51028":  {Mcc: "510", Mnc: "28", Country: "ID", Operator: "Smartfren", HuaweiCarrier: 99},
+	"51089":  {Mcc: "510", Mnc: "89", Country: "ID", Operator: "3", HuaweiCarrier: 99},
+	"51401":  {Mcc: "514", Mnc: "01", Country: "TL", Operator: "Telkomcel", HuaweiCarrier: 99},
+	"51402":  {Mcc: "514", Mnc: "02", Country: "TL", Operator: "Timor Telecom", HuaweiCarrier: 99},
+	"51403":  {Mcc: "514", Mnc: "03", Country: "TL", Operator: "Telemor", HuaweiCarrier: 99},
+	"51502":  {Mcc: "515", Mnc: "02", Country: "PH", Operator: "Globe", HuaweiCarrier: 99},
+	"51503":  {Mcc: "515", Mnc: "03", Country: "PH", Operator: "Smart", HuaweiCarrier: 99},
+	"51505":  {Mcc: "515", Mnc: "05", Country: "PH", Operator: "Sun Cellular", HuaweiCarrier: 99},
+	"51566":  {Mcc: "515", Mnc: "66", Country: "PH", Operator: "DITO", HuaweiCarrier: 99},
+	"52000":  {Mcc: "520", Mnc: "00", Country: "TH", Operator: "my", HuaweiCarrier: 99},
+	"52001":  {Mcc: "520", Mnc: "01", Country: "TH", Operator: "AIS", HuaweiCarrier: 99},
+	"52003":  {Mcc: "520", Mnc: "03", Country: "TH", Operator: "AIS", HuaweiCarrier: 99},
+	"52004":  {Mcc: "520", Mnc: "04", Country: "TH", Operator: "True", HuaweiCarrier: 99},
+	"52005":  {Mcc: "520", Mnc: "05", Country: "TH", Operator: "dtac", HuaweiCarrier: 99},
+	"52015":  {Mcc: "520", Mnc: "15", Country: "TH", Operator: "TOT", HuaweiCarrier: 99},
+	"52018":  {Mcc: "520", Mnc: "18", Country: "TH", Operator: "dtac", HuaweiCarrier: 99},
+	"52047":  {Mcc: "520", Mnc: "47", Country: "TH", Operator: "NT", HuaweiCarrier: 99},
+	"52501":  {Mcc: "525", Mnc: "01", Country: "SG", Operator: "Singtel", HuaweiCarrier: 99},
+	"52502":  {Mcc: "525", Mnc: "02", Country: "SG", Operator: "Singtel", HuaweiCarrier: 99},
+	"52503":  {Mcc: "525", Mnc: "03", Country: "SG", Operator: "M1", HuaweiCarrier: 99},
+	"52505":  {Mcc: "525", Mnc: "05", Country: "SG", Operator: "StarHub", HuaweiCarrier: 99},
+	"52510":  {Mcc: "525", Mnc: "10", Country: "SG", Operator: "SIMBA", HuaweiCarrier: 99},
+	"52811":  {Mcc: "528", Mnc: "11", Country: "BN", Operator: "DST", HuaweiCarrier: 99},
+	"53001":  {Mcc: "530", Mnc: "01", Country: "NZ", Operator: "One NZ", HuaweiCarrier: 99},
+	"53002":  {Mcc: "530", Mnc: "02", Country: "NZ", Operator: "2degrees", HuaweiCarrier: 99},
+	"53003":  {Mcc: "530", Mnc: "03", Country: "NZ", Operator: "Woosh", HuaweiCarrier: 99},
+	"53005":  {Mcc: "530", Mnc: "05", Country: "NZ", Operator: "Spark", HuaweiCarrier: 99},
+	"53024":  {Mcc: "530", Mnc: "24", Country: "NZ", Operator: "2degrees", HuaweiCarrier: 99},
+	"535140": {Mcc: "535", Mnc: "140", Country: "GU", Operator: "iConnect", HuaweiCarrier: 99},
+	"53532":  {Mcc: "535", Mnc: "32", Country: "GU", Operator: "IT&E", HuaweiCarrier: 99},
+	"53602":  {Mcc: "536", Mnc: "02", Country: "NR", Operator: "Digicel", HuaweiCarrier: 99},
+	"53701":  {Mcc: "537", Mnc: "01", Country: "PG", Operator: "bmobile", HuaweiCarrier: 99},
+	"53703":  {Mcc: "537", Mnc: "03", Country: "PG", Operator: "Digicel", HuaweiCarrier: 99},
+	"53901":  {Mcc: "539", Mnc: "01", Country: "TO", Operator: "Digicel", HuaweiCarrier: 99},
+	"53988":  {Mcc: "539", Mnc: "88", Country: "TO", Operator: "Digicel", HuaweiCarrier: 99},
+	"54001":  {Mcc: "540", Mnc: "01", Country: "SB", Operator: "BREEZE", HuaweiCarrier: 99},
+	"54002":  {Mcc: "540", Mnc: "02", Country: "SB", Operator: "Bemobile", HuaweiCarrier: 99},
+	"54101":  {Mcc: "541", Mnc: "01", Country: "VU", Operator: "SMILE", HuaweiCarrier: 99},
+	"54105":  {Mcc: "541", Mnc: "05", Country: "VU", Operator: "Digicel", HuaweiCarrier: 99},
+	"54201":  {Mcc: "542", Mnc: "01", Country: "FJ", Operator: "Vodafone", HuaweiCarrier: 99},
+	"54202":  {Mcc: "542", Mnc: "02", Country: "FJ", Operator: "Digicel", HuaweiCarrier: 99},
+	"54301":  {Mcc: "543", Mnc: "01", Country: "WF", Operator: "Manuia", HuaweiCarrier: 99},
+	"54411":  {Mcc: "544", Mnc: "11", Country: "AS", Operator: "Bluesky", HuaweiCarrier: 99},
+	"54501":  {Mcc: "545", Mnc: "01", Country: "KI", Operator: "Kiribati - ATH", HuaweiCarrier: 99},
+	"54509":  {Mcc: "545", Mnc: "09", Country: "KI", Operator: "Kiribati - Frigate Net", HuaweiCarrier: 99},
+	"54601":  {Mcc: "546", Mnc: "01", Country: "NC", Operator: "Mobilis", HuaweiCarrier: 99},
+	"54605":  {Mcc: "546", Mnc: "05", Country: "NC", Operator: "OPT", HuaweiCarrier: 99},
+	"54720":  {Mcc: "547", Mnc: "20", Country: "PF", Operator: "Vini", HuaweiCarrier: 99},
+	"54801":  {Mcc: "548", Mnc: "01", Country: "CK", Operator: "Bluesky", HuaweiCarrier: 99},
+	"54901":  {Mcc: "549", Mnc: "01", Country: "WS", Operator: "Digicel", HuaweiCarrier: 99},
+	"54927":  {Mcc: "549", Mnc: "27", Country: "WS", Operator: "Bluesky", HuaweiCarrier: 99},
+	"55001":  {Mcc: "550", Mnc: "01", Country: "FM", Operator: "FSMTC", HuaweiCarrier: 99},
+	"55101":  {Mcc: "551", Mnc: "01", Country: "MH", Operator: "MINTA", HuaweiCarrier: 99},
+	"55201":  {Mcc: "552", Mnc: "01", Country: "PW", Operator: "PNCC", HuaweiCarrier: 99},
+	"55280":  {Mcc: "552", Mnc: "80", Country: "PW", Operator: "Palau Mobile", HuaweiCarrier: 99},
+	"55301":  {Mcc: "553", Mnc: "01", Country: "TV", Operator: "TTC", HuaweiCarrier: 99},
+	"55501":  {Mcc: "555", Mnc: "01", Country: "NU", Operator: "Telecom Niue", HuaweiCarrier: 99},
+	"60201":  {Mcc: "602", Mnc: "01", Country: "EG", Operator: "Orange", HuaweiCarrier: 99},
+	"60202":  {Mcc: "602", Mnc: "02", Country: "EG", Operator: "Vodafone", HuaweiCarrier: 99},
+	"60203":  {Mcc: "602", Mnc: "03", Country: "EG", Operator: "Etisalat", HuaweiCarrier: 99},
+	"60204":  {Mcc: "602", Mnc: "04", Country: "EG", Operator: "WE", HuaweiCarrier: 99},
+	"60301":  {Mcc: "603", Mnc: "01", Country: "DZ", Operator: "Mobilis", HuaweiCarrier: 99},
+	"60302":  {Mcc: "603", Mnc: "02", Country: "DZ", Operator: "Djezzy", HuaweiCarrier: 99},
+	"60303":  {Mcc: "603", Mnc: "03", Country: "DZ", Operator: "Ooredoo", HuaweiCarrier: 99},
+	"60400":  {Mcc: "604", Mnc: "00", Country: "MA", Operator: "Orange", HuaweiCarrier: 99},
+	"60401":  {Mcc: "604", Mnc: "01", Country: "MA", Operator: "IAM", HuaweiCarrier: 99},
+	"60402":  {Mcc: "604", Mnc: "02", Country: "MA", Operator: "inwi", HuaweiCarrier: 99},
+	"60405":  {Mcc: "604", Mnc: "05", Country: "MA", Operator: "inwi", HuaweiCarrier: 99},
+	"60501":  {Mcc: "605", Mnc: "01", Country: "TN", Operator: "Orange", HuaweiCarrier: 99},
+	"60502":  {Mcc: "605", Mnc: "02", Country: "TN", Operator: "Tunisie Telecom", HuaweiCarrier: 99},
+	"60503":  {Mcc: "605", Mnc: "03", Country: "TN", Operator: "Ooredoo", HuaweiCarrier: 99},
+	"60506":  {Mcc: "605", Mnc: "06", Country: "TN", Operator: "Lycamobile", HuaweiCarrier: 99},
+	"60600":  {Mcc: "606", Mnc: "00", Country: "LY", Operator: "Libyana", HuaweiCarrier: 99},
+	"60601":  {Mcc: "606", Mnc: "01", Country: "LY", Operator: "Madar", HuaweiCarrier: 99},
+	"60701":  {Mcc: "607", Mnc: "01", Country: "GM", Operator: "Gamcel", HuaweiCarrier: 99},
+	"60702":  {Mcc: "607", Mnc: "02", Country: "GM", Operator: "Africell", HuaweiCarrier: 99},
+	"60703":  {Mcc: "607", Mnc: "03", Country: "GM", Operator: "Comium", HuaweiCarrier: 99},
+	"60704":  {Mcc: "607", Mnc: "04", Country: "GM", Operator: "QCell", HuaweiCarrier: 99},
+	"60801":  {Mcc: "608", Mnc: "01", Country: "SN", Operator: "Orange", HuaweiCarrier: 99},
+	"60802":  {Mcc: "608", Mnc: "02", Country: "SN", Operator: "Free", HuaweiCarrier: 99},
+	"60803":  {Mcc: "608", Mnc: "03", Country: "SN", Operator: "Expresso", HuaweiCarrier: 99},
+	"60901":  {Mcc: "609", Mnc: "01", Country: "MR", Operator: "Mattel", HuaweiCarrier: 99},
+	"60910":  {Mcc: "609", Mnc: "10", Country: "MR", Operator: "Mauritel", HuaweiCarrier: 99},
+	"61001":  {Mcc: "610", Mnc: "01", Country: "ML", Operator: "Malitel", HuaweiCarrier: 99},
+	"61002":  {Mcc: "610", Mnc: "02", Country: "ML", Operator: "Orange", HuaweiCarrier: 99},
+	"61101":  {Mcc: "611", Mnc: "01", Country: "GN", Operator: "Orange", HuaweiCarrier: 99},
+	"61102":  {Mcc: "611", Mnc: "02", Country: "GN", Operator: "Sotelgui", HuaweiCarrier: 99},
+	"61104":  {Mcc: "611", Mnc: "04", Country: "GN", Operator: "MTN", HuaweiCarrier: 99},
+	"61201":  {Mcc: "612", Mnc: "01", Country: "CI", Operator: "Moov", HuaweiCarrier: 99},
+	"61202":  {Mcc: "612", Mnc: "02", Country: "CI", Operator: "Moov", HuaweiCarrier: 99},
+	"61203":  {Mcc: "612", Mnc: "03", Country: "CI", Operator: "Orange", HuaweiCarrier: 99},
+	"61204":  {Mcc: "612", Mnc: "04", Country: "CI", Operator: "Koz", HuaweiCarrier: 99},
+	"61205":  {Mcc: "612", Mnc: "05", Country: "CI", Operator: "MTN", HuaweiCarrier: 99},
+	"61207":  {Mcc: "612", Mnc: "07", Country: "CI", Operator: "GreenN", HuaweiCarrier: 99},
+	"61301":  {Mcc: "613", Mnc: "01", Country: "BF", Operator: "Telmob", HuaweiCarrier: 99},
+	"61302":  {Mcc: "613", Mnc: "02", Country: "BF", Operator: "Orange", HuaweiCarrier: 99},
+	"61303":  {Mcc: "613", Mnc: "03", Country: "BF", Operator: "Telecel Faso", HuaweiCarrier: 99},
+	"61401":  {Mcc: "614", Mnc: "01", Country: "NE", Operator: "SahelCom", HuaweiCarrier: 99},
+	"61402":  {Mcc: "614", Mnc: "02", Country: "NE", Operator: "Airtel", HuaweiCarrier: 99},
+	"61403":  {Mcc: "614", Mnc: "03", Country: "NE", Operator: "Moov", HuaweiCarrier: 99},
+	"61404":  {Mcc: "614", Mnc: "04", Country: "NE", Operator: "Zamani", HuaweiCarrier: 99},
+	"61501":  {Mcc: "615", Mnc: "01", Country: "TG", Operator: "Togo Cell", HuaweiCarrier: 99},
+	"61503":  {Mcc: "615", Mnc: "03", Country: "TG", Operator: "Moov", HuaweiCarrier: 99},
+	"61601":  {Mcc: "616", Mnc: "01", Country: "BJ", Operator: "Libercom", HuaweiCarrier: 99},
+	"61602":  {Mcc: "616", Mnc: "02", Country: "BJ", Operator: "Moov", HuaweiCarrier: 99},
+	"61603":  {Mcc: "616", Mnc: "03", Country: "BJ", Operator: "MTN", HuaweiCarrier: 99},
+	"61701":  {Mcc: "617", Mnc: "01", Country: "MU", Operator: "my.t", HuaweiCarrier: 99},
+	"61710":  {Mcc: "617", Mnc: "10", Country: "MU", Operator: "Emtel", HuaweiCarrier: 99},
+	"61801":  {Mcc: "618", Mnc: "01", Country: "LR", Operator: "Lonestar Cell MTN", HuaweiCarrier: 99},
+	"61807":  {Mcc: "618", Mnc: "07", Country: "LR", Operator: "Orange", HuaweiCarrier: 99},
+	"61901":  {Mcc: "619", Mnc: "01", Country: "SL", Operator: "Orange", HuaweiCarrier: 99},
+	"61902":  {Mcc: "619", Mnc: "02", Country: "SL", Operator: "Africell", HuaweiCarrier: 99},
+	"61903":  {Mcc: "619", Mnc: "03", Country: "SL", Operator: "Africell", HuaweiCarrier: 99},
+	"62001":  {Mcc: "620", Mnc: "01", Country: "GH", Operator: "MTN", HuaweiCarrier: 99},
+	"62002":  {Mcc: "620", Mnc: "02", Country: "GH", Operator: "Telecel", HuaweiCarrier: 99},
+	"62003":  {Mcc: "620", Mnc: "03", Country: "GH", Operator: "AirtelTigo", HuaweiCarrier: 99},
+	"62006":  {Mcc: "620", Mnc: "06", Country: "GH", Operator: "AirtelTigo", HuaweiCarrier: 99},
+	"62007":  {Mcc: "620", Mnc: "07", Country: "GH", Operator: "Glo", HuaweiCarrier: 99},
+	"62120":  {Mcc: "621", Mnc: "20", Country: "NG", Operator: "Airtel", HuaweiCarrier: 99},
+	"62125":  {Mcc: "621", Mnc: "25", Country: "NG", Operator: "Visafone", HuaweiCarrier: 99},
+	"62127":  {Mcc: "621", Mnc: "27", Country: "NG", Operator: "Smile", HuaweiCarrier: 99},
+	"62130":  {Mcc: "621", Mnc: "30", Country: "NG", Operator: "MTN", HuaweiCarrier: 99},
+	"62140":  {Mcc: "621", Mnc: "40", Country: "NG", Operator: "Ntel", HuaweiCarrier: 99},
+	"62150":  {Mcc: "621", Mnc: "50", Country: "NG", Operator: "Glo", HuaweiCarrier: 99},
+	"62160":  {Mcc: "621", Mnc: "60", Country: "NG", Operator: "9mobile", HuaweiCarrier: 99},
+	"62201":  {Mcc: "622", Mnc: "01", Country: "TD", Operator: "Airtel", HuaweiCarrier: 99},
+	"62203":  {Mcc: "622", Mnc: "03", Country: "TD", Operator: "Moov", HuaweiCarrier: 99},
+	"62301":  {Mcc: "623", Mnc: "01", Country: "CF", Operator: "Moov", HuaweiCarrier: 99},
+	"62303":  {Mcc: "623", Mnc: "03", Country: "CF", Operator: "Orange", HuaweiCarrier: 99},
+	"62304":  {Mcc: "623", Mnc: "04", Country: "CF", Operator: "Telecel", HuaweiCarrier: 99},
+	"62401":  {Mcc: "624", Mnc: "01", Country: "CM", Operator: "MTN", HuaweiCarrier: 99},
+	"62402":  {Mcc: "624", Mnc: "02", Country: "CM", Operator: "Orange", HuaweiCarrier: 99},
+	"62404":  {Mcc: "624", Mnc: "04", Country: "CM", Operator: "Nexttel", HuaweiCarrier: 99},
+	"62501":  {Mcc: "625", Mnc: "01", Country: "CV", Operator: "CVMOVEL", HuaweiCarrier: 99},
+	"62502":  {Mcc: "625", Mnc: "02", Country: "CV", Operator: "Unitel T+", HuaweiCarrier: 99},
+	"62601":  {Mcc: "626", Mnc: "01", Country: "ST", Operator: "CSTmovel", HuaweiCarrier: 99},
+	"62602":  {Mcc: "626", Mnc: "02", Country: "ST", Operator: "Unitel STP", HuaweiCarrier: 99},
+	"62701":  {Mcc: "627", Mnc: "01", Country: "GQ", Operator: "Orange GQ", HuaweiCarrier: 99},
+	"62703":  {Mcc: "627", Mnc: "03", Country: "GQ", Operator: "Muni", HuaweiCarrier: 99},
+	"62801":  {Mcc: "628", Mnc: "01", Country: "GA", Operator: "Libertis", HuaweiCarrier: 99},
+	"62802":  {Mcc: "628", Mnc: "02", Country: "GA", Operator: "Moov", HuaweiCarrier: 99},
+	"62803":  {Mcc: "628", Mnc: "03", Country: "GA", Operator: "Airtel", HuaweiCarrier: 99},
+	"62901":  {Mcc: "629", Mnc: "01", Country: "CG", Operator: "Airtel", HuaweiCarrier: 99},
+	"62910":  {Mcc: "629", Mnc: "10", Country: "CG", Operator: "MTN", HuaweiCarrier: 99},
+	"63001":  {Mcc: "630", Mnc: "01", Country: "CG", Operator: "Vodacom", HuaweiCarrier: 99},
+	"63002":  {Mcc: "630", Mnc: "02", Country: "CG", Operator: "Airtel", HuaweiCarrier: 99},
+	"63086":  {Mcc: "630", Mnc: "86", Country: "CG", Operator: "Orange", HuaweiCarrier: 99},
+	"63102":  {Mcc: "631", Mnc: "02", Country: "AO", Operator: "UNITEL", HuaweiCarrier: 99},
+	"63104":  {Mcc: "631", Mnc: "04", Country: "AO", Operator: "Movicel", HuaweiCarrier: 99},
+	"63201":  {Mcc: "632", Mnc: "01", Country: "GW", Operator: "Guinetel", HuaweiCarrier: 99},
+	"63202":  {Mcc: "632", Mnc: "02", Country: "GW", Operator: "MTN", HuaweiCarrier: 99},
+	"63203":  {Mcc: "632", Mnc: "03", Country: "GW", Operator: "Orange", HuaweiCarrier: 99},
+	"63301":  {Mcc: "633", Mnc: "01", Country: "SC", Operator: "Cable & Wireless", HuaweiCarrier: 99},
+	"63310":  {Mcc: "633", Mnc: "10", Country: "SC", Operator: "Airtel", HuaweiCarrier: 99},
+	"63401":  {Mcc: "634", Mnc: "01", Country: "SD", Operator: "Zain", HuaweiCarrier: 99},
+	"63402":  {Mcc: "634", Mnc: "02", Country: "SD", Operator: "MTN", HuaweiCarrier: 99},
+	"63407":  {Mcc: "634", Mnc: "07", Country: "SD", Operator: "Sudani One", HuaweiCarrier: 99},
+	"63510":  {Mcc: "635", Mnc: "10", Country: "RW", Operator: "MTN", HuaweiCarrier: 99},
+	"63513":  {Mcc: "635", Mnc: "13", Country: "RW", Operator: "Airtel", HuaweiCarrier: 99},
+	"63514":  {Mcc: "635", Mnc: "14", Country: "RW", Operator: "Airtel", HuaweiCarrier: 99},
+	"63601":  {Mcc: "636", Mnc: "01", Country: "ET", Operator: "Ethio Telecom", HuaweiCarrier: 99},
+	"63602":  {Mcc: "636", Mnc: "02", Country: "ET", Operator: "Safaricom Ethiopia", HuaweiCarrier: 99},
+	"63701":  {Mcc: "637", Mnc: "01", Country: "SO", Operator: "Telesom", HuaweiCarrier: 99},
+	"63704":  {Mcc: "637", Mnc: "04", Country: "SO", Operator: "Somafone", HuaweiCarrier: 99},
+	"63730":  {Mcc: "637", Mnc: "30", Country: "SO", Operator: "Golis", HuaweiCarrier: 99},
+	"63771":  {Mcc: "637", Mnc: "71", Country: "SO", Operator: "Somtel", HuaweiCarrier: 99},
+	"63782":  {Mcc: "637", Mnc: "82", Country: "SO", Operator: "Telcom", HuaweiCarrier: 99},
+	"63801":  {Mcc: "638", Mnc: "01", Country: "DJ", Operator: "Evatis", HuaweiCarrier: 99},
+	"63802":  {Mcc: "638", Mnc: "02", Country: "DJ", Operator: "Djibouti Telecom", HuaweiCarrier: 99},
+	"63902":  {Mcc: "639", Mnc: "02", Country: "KE", Operator: "Safaricom", HuaweiCarrier: 99},
+	"63903":  {Mcc: "639", Mnc: "03", Country: "KE", Operator: "Airtel", HuaweiCarrier: 99},
+	"63905":  {Mcc: "639", Mnc: "05", Country: "KE", Operator: "Telkom", HuaweiCarrier: 99},
+	"63907":  {Mcc: "639", Mnc: "07", Country: "KE", Operator: "Telkom", HuaweiCarrier: 99},
+	"64002":  {Mcc: "640", Mnc: "02", Country: "TZ", Operator: "Tigo", HuaweiCarrier: 99},
+	"64004":  {Mcc: "640", Mnc: "04", Country: "TZ", Operator: "Vodacom", HuaweiCarrier: 99},
+	"64005":  {Mcc: "640", Mnc: "05", Country: "TZ", Operator: "Airtel", HuaweiCarrier: 99},
+	"64007":  {Mcc: "640", Mnc: "07", Country: "TZ", Operator: "TTCL", HuaweiCarrier: 99},
+	"64009":  {Mcc: "640", Mnc: "09", Country: "TZ", Operator: "Halotel", HuaweiCarrier: 99},
+	"64101":  {Mcc: "641", Mnc: "01", Country: "UG", Operator: "Airtel", HuaweiCarrier: 99},
+	"64110":  {Mcc: "641", Mnc: "10", Country: "UG", Operator: "MTN", HuaweiCarrier: 99},
+	"64111":  {Mcc: "641", Mnc: "11", Country: "UG", Operator: "UTL", HuaweiCarrier: 99},
+	"64114":  {Mcc: "641", Mnc: "14", Country: "UG", Operator: "Africell", HuaweiCarrier: 99},
+	"64122":  {Mcc: "641", Mnc: "22", Country: "UG", Operator: "Airtel", HuaweiCarrier: 99},
+	"64201":  {Mcc: "642", Mnc: "01", Country: "BI", Operator: "econet Leo", HuaweiCarrier: 99},
+	"64203":  {Mcc: "642", Mnc: "03", Country: "BI", Operator: "Onatel", HuaweiCarrier: 99},
+	"64282":  {Mcc: "642", Mnc: "82", Country: "BI", Operator: "econet Leo", HuaweiCarrier: 99},
+	"64301":  {Mcc: "643", Mnc: "01", Country: "MZ", Operator: "mCel", HuaweiCarrier: 99},
+	"64303":  {Mcc: "643", Mnc: "03", Country: "MZ", Operator: "Movitel", HuaweiCarrier: 99},
+	"64304":  {Mcc: "643", Mnc: "04", Country: "MZ", Operator: "Vodacom", HuaweiCarrier: 99},
+	"64501":  {Mcc: "645", Mnc: "01", Country: "ZM", Operator: "Airtel", HuaweiCarrier: 99},
+	"64502":  {Mcc: "645", Mnc: "02", Country: "ZM", Operator: "MTN", HuaweiCarrier: 99},
+	"64503":  {Mcc: "645", Mnc: "03", Country: "ZM", Operator: "ZAMTEL", HuaweiCarrier: 99},
+	"64601":  {Mcc: "646", Mnc: "01", Country: "MG", Operator: "Airtel", HuaweiCarrier: 99},
+	"64602":  {Mcc: "646", Mnc: "02", Country: "MG", Operator: "Orange", HuaweiCarrier: 99},
+	"64604":  {Mcc: "646", Mnc: "04", Country: "MG", Operator: "Telma", HuaweiCarrier: 99},
+	"64700":  {Mcc: "647", Mnc: "00", Country: "RE", Operator: "Orange", HuaweiCarrier: 99},
+	"64710":  {Mcc: "647", Mnc: "10", Country: "RE", Operator: "SFR", HuaweiCarrier: 99},
+	"64801":  {Mcc: "648", Mnc: "01", Country: "ZW", Operator: "Net*One", HuaweiCarrier: 99},
+	"64803":  {Mcc: "648", Mnc: "03", Country: "ZW", Operator: "Telecel", HuaweiCarrier: 99},
+	"64804":  {Mcc: "648", Mnc: "04", Country: "ZW", Operator: "Econet", HuaweiCarrier: 99},
+	"64901":  {Mcc: "649", Mnc: "01", Country: "NA", Operator: "MTC", HuaweiCarrier: 99},
+	"64903":  {Mcc: "649", Mnc: "03", Country: "NA", Operator: "TN Mobile", HuaweiCarrier: 99},
+	"65001":  {Mcc: "650", Mnc: "01", Country: "MW", Operator: "TNM", HuaweiCarrier: 99},
+	"65010":  {Mcc: "650", Mnc: "10", Country: "MW", Operator: "Airtel", HuaweiCarrier: 99},
+	"65101":  {Mcc: "651", Mnc: "01", Country: "LS", Operator: "Vodacom", HuaweiCarrier: 99},
+	"65102":  {Mcc: "651", Mnc: "02", Country: "LS", Operator: "Econet Telecom", HuaweiCarrier: 99},
+	"65201":  {Mcc: "652", Mnc: "01", Country: "BW", Operator: "Mascom", HuaweiCarrier: 99},
+	"65202":  {Mcc: "652", Mnc: "02", Country: "BW", Operator: "Orange", HuaweiCarrier: 99},
+	"65204":  {Mcc: "652", Mnc: "04", Country: "BW", Operator: "BTC Mobile", HuaweiCarrier: 99},
+	"65310":  {Mcc: "653", Mnc: "10", Country: "SZ", Operator: "Swazi MTN", HuaweiCarrier: 99},
+	"65401":  {Mcc: "654", Mnc: "01", Country: "KM", Operator: "Comores Telecom", HuaweiCarrier: 99},
+	"65501":  {Mcc: "655", Mnc: "01", Country: "ZA", Operator: "Vodacom", HuaweiCarrier: 99},
+	"65502":  {Mcc: "655", Mnc: "02", Country: "ZA", Operator: "Telkom", HuaweiCarrier: 99},
+	"65507":  {Mcc: "655", Mnc: "07", Country: "ZA", Operator: "Cell C", HuaweiCarrier: 99},
+	"65510":  {Mcc: "655", Mnc: "10", Country: "ZA", Operator: "MTN", HuaweiCarrier: 99},
+	"65512":  {Mcc: "655", Mnc: "12", Country: "ZA", Operator: "MTN", HuaweiCarrier: 99},
+	"65519":  {Mcc: "655", Mnc: "19", Country: "ZA", Operator: "Rain", HuaweiCarrier: 99},
+	"65538":  {Mcc: "655", Mnc: "38", Country: "ZA", Operator: "iBurst", HuaweiCarrier: 99},
+	"65573":  {Mcc: "655", Mnc: "73", Country: "ZA", Operator: "Rain", HuaweiCarrier: 99},
+	"65574":  {Mcc: "655", Mnc: "74", Country: "ZA", Operator: "Rain", HuaweiCarrier: 99},
+	"65701":  {Mcc: "657", Mnc: "01", Country: "ER", Operator: "Eritel", HuaweiCarrier: 99},
+	"65801":  {Mcc: "658", Mnc: "01", Country: "SH", Operator: "Sure", HuaweiCarrier: 99},
+	"65902":  {Mcc: "659", Mnc: "02", Country: "SS", Operator: "MTN", HuaweiCarrier: 99},
+	"65904":  {Mcc: "659", Mnc: "04", Country: "SS", Operator: "Zain", HuaweiCarrier: 99},
+	"65906":  {Mcc: "659", Mnc: "06", Country: "SS", Operator: "Zain", HuaweiCarrier: 99},
+	"70267":  {Mcc: "702", Mnc: "67", Country: "BZ", Operator: "Digicel", HuaweiCarrier: 99},
+	"70269":  {Mcc: "702", Mnc: "69", Country: "BZ", Operator: "Smart", HuaweiCarrier: 99},
+	"70401":  {Mcc: "704", Mnc: "01", Country: "GT", Operator: "Claro", HuaweiCarrier: 99},
+	"70402":  {Mcc: "704", Mnc: "02", Country: "GT", Operator: "Tigo", HuaweiCarrier: 99},
+	"70403":  {Mcc: "704", Mnc: "03", Country: "GT", Operator: "Movistar", HuaweiCarrier: 99},
+	"70601":  {Mcc: "706", Mnc: "01", Country: "SV", Operator: "Claro", HuaweiCarrier: 99},
+	"70603":  {Mcc: "706", Mnc: "03", Country: "SV", Operator: "Tigo", HuaweiCarrier: 99},
+	"70604":  {Mcc: "706", Mnc: "04", Country: "SV", Operator: "Movistar", HuaweiCarrier: 99},
+	"708001": {Mcc: "708", Mnc: "001", Country: "HN", Operator: "Claro", HuaweiCarrier: 99},
+	"708002": {Mcc: "708", Mnc: "002", Country: "HN", Operator: "Tigo", HuaweiCarrier: 99},
+	"71021":  {Mcc: "710", Mnc: "21", Country: "NI", Operator: "Claro", HuaweiCarrier: 99},
+	"71030":  {Mcc: "710", Mnc: "30", Country: "NI", Operator: "Movistar", HuaweiCarrier: 99},
+	"71201":  {Mcc: "712", Mnc: "01", Country: "CR", Operator: "Kolbi ICE", HuaweiCarrier: 99},
+	"71203":  {Mcc: "712", Mnc: "03", Country: "CR", Operator: "Claro", HuaweiCarrier: 99},
+	"71204":  {Mcc: "712", Mnc: "04", Country: "CR", Operator: "Liberty", HuaweiCarrier: 99},
+	"71401":  {Mcc: "714", Mnc: "01", Country: "PA", Operator: "Cable & Wireless", HuaweiCarrier: 99},
+	"71402":  {Mcc: "714", Mnc: "02", Country: "PA", Operator: "Movistar", HuaweiCarrier: 99},
+	"71403":  {Mcc: "714", Mnc: "03", Country: "PA", Operator: "Claro", HuaweiCarrier: 99},
+	"71404":  {Mcc: "714", Mnc: "04", Country: "PA", Operator: "Digicel", HuaweiCarrier: 99},
+	"71606":  {Mcc: "716", Mnc: "06", Country: "PE", Operator: "Movistar", HuaweiCarrier: 99},
+	"71610":  {Mcc: "716", Mnc: "10", Country: "PE", Operator: "Claro", HuaweiCarrier: 99},
+	"71615":  {Mcc: "716", Mnc: "15", Country: "PE", Operator: "Bitel", HuaweiCarrier: 99},
+	"71617":  {Mcc: "716", Mnc: "17", Country: "PE", Operator: "Entel", HuaweiCarrier: 99},
+	"72207":  {Mcc: "722", Mnc: "07", Country: "AR", Operator: "Movistar", HuaweiCarrier: 99},
+	"722070": {Mcc: "722", Mnc: "070", Country: "AR", Operator: "Movistar", HuaweiCarrier: 99},
+	"722310": {Mcc: "722", Mnc: "310", Country: "AR", Operator: "Claro", HuaweiCarrier: 99},
+	"722320": {Mcc: "722", Mnc: "320", Country: "AR", Operator: "Claro", HuaweiCarrier: 99},
+	"722330": {Mcc: "722", Mnc: "330", Country: "AR", Operator: "Claro", HuaweiCarrier: 99},
+	"72234":  {Mcc: "722", Mnc: "34", Country: "AR", Operator: "Personal", HuaweiCarrier: 99},
+	"722341": {Mcc: "722", Mnc: "341", Country: "AR", Operator: "Personal", HuaweiCarrier: 99},
+	"72400":  {Mcc: "724", Mnc: "00", Country: "BR", Operator: "Nextel", HuaweiCarrier: 99},
+	"72402":  {Mcc: "724", Mnc: "02", Country: "BR", Operator: "TIM", HuaweiCarrier: 99},
+	"72403":  {Mcc: "724", Mnc: "03", Country: "BR", Operator: "TIM", HuaweiCarrier: 99},
+	"72404":  {Mcc: "724", Mnc: "04", Country: "BR", Operator: "TIM", HuaweiCarrier: 99},
+	"72405":  {Mcc: "724", Mnc: "05", Country: "BR", Operator: "Claro", HuaweiCarrier: 99},
+	"72406":  {Mcc: "724", Mnc: "06", Country: "BR", Operator: "Vivo", HuaweiCarrier: 99},
+	"72410":  {Mcc: "724", Mnc: "10", Country: "BR", Operator: "Vivo", HuaweiCarrier: 99},
+	"72411":  {Mcc: "724", Mnc: "11", Country: "BR", Operator: "Vivo", HuaweiCarrier: 99},
+	"72415":  {Mcc: "724", Mnc: "15", Country: "BR", Operator: "Sercomtel", HuaweiCarrier: 99},
+	"72416":  {Mcc: "724", Mnc: "16", Country: "BR", Operator: "Brasil Telecom", HuaweiCarrier: 99},
+	"72423":  {Mcc: "724", Mnc: "23", Country: "BR", Operator: "Vivo", HuaweiCarrier: 99},
+	"72431":  {Mcc: "724", Mnc: "31", Country: "BR", Operator: "Oi", HuaweiCarrier: 99},
+	"72432":  {Mcc: "724", Mnc: "32", Country: "BR", Operator: "Algar Telecom", HuaweiCarrier: 99},
+	"72433":  {Mcc: "724", Mnc: "33", Country: "BR", Operator: "Algar Telecom", HuaweiCarrier: 99},
+	"72434":  {Mcc: "724", Mnc: "34", Country: "BR", Operator: "Algar Telecom", HuaweiCarrier: 99},
+	"72439":  {Mcc: "724", Mnc: "39", Country: "BR", Operator: "Nextel", HuaweiCarrier: 99},
+	"73001":  {Mcc: "730", Mnc: "01", Country: "CL", Operator: "Entel", HuaweiCarrier: 99},
+	"73002":  {Mcc: "730", Mnc: "02", Country: "CL", Operator: "Movistar", HuaweiCarrier: 99},
+	"73003":  {Mcc: "730", Mnc: "03", Country: "CL", Operator: "Claro", HuaweiCarrier: 99},
+	"73007":  {Mcc: "730", Mnc: "07", Country: "CL", Operator: "Movistar", HuaweiCarrier: 99},
+	"73009":  {Mcc: "730", Mnc: "09", Country: "CL", Operator: "WOM", HuaweiCarrier: 99},
+	"73010":  {Mcc: "730", Mnc: "10", Country: "CL", Operator: "Entel", HuaweiCarrier: 99},
+	"732101": {Mcc: "732", Mnc: "101", Country: "CO", Operator: "Claro", HuaweiCarrier: 99},
+	"732103": {Mcc: "732", Mnc: "103", Country: "CO", Operator: "Tigo", HuaweiCarrier: 99},
+	"732111": {Mcc: "732", Mnc: "111", Country: "CO", Operator: "Tigo", HuaweiCarrier: 99},
+	"732123": {Mcc: "732", Mnc: "123", Country: "CO", Operator: "Movistar", HuaweiCarrier: 99},
+	"732130": {Mcc: "732", Mnc: "130", Country: "CO", Operator: "Avantel", HuaweiCarrier: 99},
+	"732360": {Mcc: "732", Mnc: "360", Country: "CO", Operator: "WOM", HuaweiCarrier: 99},
+	"73402":  {Mcc: "734", Mnc: "02", Country: "VE", Operator: "Digitel", HuaweiCarrier: 99},
+	"73403":  {Mcc: "734", Mnc: "03", Country: "VE", Operator: "Digitel", HuaweiCarrier: 99},
+	"73404":  {Mcc: "734", Mnc: "04", Country: "VE", Operator: "Movistar", HuaweiCarrier: 99},
+	"73406":  {Mcc: "734", Mnc: "06", Country: "VE", Operator: "Movilnet", HuaweiCarrier: 99},
+	"73601":  {Mcc: "736", Mnc: "01", Country: "BO", Operator: "Viva", HuaweiCarrier: 99},
+	"73602":  {Mcc: "736", Mnc: "02", Country: "BO", Operator: "Entel", HuaweiCarrier: 99},
+	"73603":  {Mcc: "736", Mnc: "03", Country: "BO", Operator: "Tigo", HuaweiCarrier: 99},
+	"73801":  {Mcc: "738", Mnc: "01", Country: "GY", Operator: "Digicel", HuaweiCarrier: 99},
+	"73802":  {Mcc: "738", Mnc: "02", Country: "GY", Operator: "GT&T Cellink Plus", HuaweiCarrier: 99},
+	"74000":  {Mcc: "740", Mnc: "00", Country: "EC", Operator: "Movistar", HuaweiCarrier: 99},
+	"74001":  {Mcc: "740", Mnc: "01", Country: "EC", Operator: "Claro", HuaweiCarrier: 99},
+	"74002":  {Mcc: "740", Mnc: "02", Country: "EC", Operator: "CNT Mobile", HuaweiCarrier: 99},
+	"74201":  {Mcc: "742", Mnc: "01", Country: "GF", Operator: "Orange", HuaweiCarrier: 99},
+	"74401":  {Mcc: "744", Mnc: "01", Country: "PY", Operator: "VOX", HuaweiCarrier: 99},
+	"74402":  {Mcc: "744", Mnc: "02", Country: "PY", Operator: "Claro", HuaweiCarrier: 99},
+	"74404":  {Mcc: "744", Mnc: "04", Country: "PY", Operator: "Tigo", HuaweiCarrier: 99},
+	"74405":  {Mcc: "744", Mnc: "05", Country: "PY", Operator: "Personal", HuaweiCarrier: 99},
+	"74602":  {Mcc: "746", Mnc: "02", Country: "SR", Operator: "Telesur", HuaweiCarrier: 99},
+	"74603":  {Mcc: "746", Mnc: "03", Country: "SR", Operator: "Digicel", HuaweiCarrier: 99},
+	"74801":  {Mcc: "748", Mnc: "01", Country: "UY", Operator: "Antel", HuaweiCarrier: 99},
+	"74807":  {Mcc: "748", Mnc: "07", Country: "UY", Operator: "Movistar", HuaweiCarrier: 99},
+	"74810":  {Mcc: "748", Mnc: "10", Country: "UY", Operator: "Claro", HuaweiCarrier: 99},
+	"750001": {Mcc: "750", Mnc: "001", Country: "FK", Operator: "Sure", HuaweiCarrier: 99},
+}
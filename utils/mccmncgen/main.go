@@ -0,0 +1,263 @@
+// mccmncgen generates utils/mccMncList.go from utils/mccMnc.csv, run it with go generate ./utils
+//
+// To refresh the dataset from the public MCC/MNC table (https://github.com/musalbas/mcc-mnc-table, mcc-mnc-table.csv)
+// run it once with -source: operators missing from mccMnc.csv are merged in and mccMnc.csv is rewritten
+//
+//	go run ./mccmncgen -in mccMnc.csv -out mccMncList.go -source mcc-mnc-table.csv
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var csvHeader = []string{"mcc", "mnc", "iso", "country", "operator", "huaweiCarrier"}
+
+var mccPattern = regexp.MustCompile(`^[0-9]{3}$`)
+var mncPattern = regexp.MustCompile(`^[0-9]{2,3}$`)
+var isoPattern = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// huawei network.carrier: 1 China Unicom, 2 China Mobile, 3 China Telecom, 99 other
+var huaweiCarriers = map[string]bool{"1": true, "2": true, "3": true, "99": true}
+
+type mccRow struct {
+	mcc     string
+	iso     string
+	country string
+}
+
+type mncRow struct {
+	mcc      string
+	mnc      string
+	operator string
+	carrier  string
+}
+
+func main() {
+	in := flag.String("in", "mccMnc.csv", "MCC/MNC dataset")
+	out := flag.String("out", "mccMncList.go", "generated go file")
+	source := flag.String("source", "", "public mcc-mnc-table.csv to merge into the dataset")
+	flag.Parse()
+
+	mccRows, mncRows, err := readDataset(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *source != "" {
+		if mccRows, mncRows, err = mergeSource(*source, mccRows, mncRows); err != nil {
+			log.Fatal(err)
+		}
+		if err := writeDataset(*in, mccRows, mncRows); err != nil {
+			log.Fatal(err)
+		}
+	}
+	code, err := generate(mccRows, mncRows)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, code, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// readDataset: rows with an empty mnc give the country of a mcc, the other rows are operators
+func readDataset(path string) ([]mccRow, []mncRow, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, err
+	}
+	if strings.Join(header, ",") != strings.Join(csvHeader, ",") {
+		return nil, nil, errors.New("unexpected csv header: " + strings.Join(header, ","))
+	}
+
+	var mccRows []mccRow
+	var mncRows []mncRow
+	var countries = make(map[string]bool)
+	var operators = make(map[string]bool)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		mcc, mnc, iso, country, operator, carrier := record[0], record[1], record[2], record[3], record[4], record[5]
+		if !mccPattern.MatchString(mcc) {
+			return nil, nil, fmt.Errorf("line %d: invalid mcc %q", line, mcc)
+		}
+		if !isoPattern.MatchString(iso) {
+			return nil, nil, fmt.Errorf("line %d: invalid iso %q", line, iso)
+		}
+		if mnc == "" {
+			if countries[mcc] {
+				return nil, nil, fmt.Errorf("line %d: duplicated mcc %s", line, mcc)
+			}
+			countries[mcc] = true
+			mccRows = append(mccRows, mccRow{mcc: mcc, iso: iso, country: country})
+			continue
+		}
+		if !mncPattern.MatchString(mnc) {
+			return nil, nil, fmt.Errorf("line %d: invalid mnc %q", line, mnc)
+		}
+		if operators[mcc+mnc] {
+			return nil, nil, fmt.Errorf("line %d: duplicated mcc mnc %s-%s", line, mcc, mnc)
+		}
+		if !huaweiCarriers[carrier] {
+			return nil, nil, fmt.Errorf("line %d: invalid huaweiCarrier %q", line, carrier)
+		}
+		operators[mcc+mnc] = true
+		mncRows = append(mncRows, mncRow{mcc: mcc, mnc: mnc, operator: operator, carrier: carrier})
+	}
+	for _, row := range mncRows {
+		if !countries[row.mcc] {
+			return nil, nil, fmt.Errorf("mcc %s of mnc %s has no country row", row.mcc, row.mnc)
+		}
+	}
+	sortRows(mccRows, mncRows)
+	return mccRows, mncRows, nil
+}
+
+func sortRows(mccRows []mccRow, mncRows []mncRow) {
+	sort.Slice(mccRows, func(i, j int) bool { return mccRows[i].mcc < mccRows[j].mcc })
+	sort.Slice(mncRows, func(i, j int) bool {
+		return mncRows[i].mcc+"-"+mncRows[i].mnc < mncRows[j].mcc+"-"+mncRows[j].mnc
+	})
+}
+
+// mergeSource: adds the mcc and mnc of the public table (MCC,MCC (int),MNC,MNC (int),ISO,Country,Country Code,Network)
+// which are missing from the dataset, rows already in the dataset are kept as they are
+func mergeSource(path string, mccRows []mccRow, mncRows []mncRow) ([]mccRow, []mncRow, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	if _, err := reader.Read(); err != nil {
+		return nil, nil, err
+	}
+	var countries = make(map[string]bool, len(mccRows))
+	for _, row := range mccRows {
+		countries[row.mcc] = true
+	}
+	var operators = make(map[string]bool, len(mncRows))
+	for _, row := range mncRows {
+		operators[row.mcc+row.mnc] = true
+	}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(record) < 8 {
+			continue
+		}
+		mcc, mnc, iso, country, operator := record[0], record[2], strings.ToUpper(record[4]), record[5], strings.TrimSpace(record[7])
+		// the public table uses placeholders such as "n/a" for unassigned or shared codes
+		if !mccPattern.MatchString(mcc) || !mncPattern.MatchString(mnc) || !isoPattern.MatchString(iso) {
+			continue
+		}
+		if !countries[mcc] {
+			countries[mcc] = true
+			mccRows = append(mccRows, mccRow{mcc: mcc, iso: iso, country: country})
+		}
+		if operators[mcc+mnc] || operator == "" {
+			continue
+		}
+		operators[mcc+mnc] = true
+		mncRows = append(mncRows, mncRow{mcc: mcc, mnc: mnc, operator: operator, carrier: getHuaweiCarrier(mcc, operator)})
+	}
+	sortRows(mccRows, mncRows)
+	return mccRows, mncRows, nil
+}
+
+// getHuaweiCarrier: only the mainland china operators have their own huawei carrier code
+func getHuaweiCarrier(mcc string, operator string) string {
+	if mcc != "460" {
+		return "99"
+	}
+	operator = strings.ToLower(operator)
+	switch {
+	case strings.Contains(operator, "unicom"):
+		return "1"
+	case strings.Contains(operator, "mobile"):
+		return "2"
+	case strings.Contains(operator, "telecom"):
+		return "3"
+	}
+	return "99"
+}
+
+// writeDataset: rewrites the dataset, each mcc row is followed by its operators
+func writeDataset(path string, mccRows []mccRow, mncRows []mncRow) error {
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+	next := 0
+	for _, country := range mccRows {
+		if err := writer.Write([]string{country.mcc, "", country.iso, country.country, "", ""}); err != nil {
+			return err
+		}
+		for ; next < len(mncRows) && mncRows[next].mcc == country.mcc; next++ {
+			row := mncRows[next]
+			if err := writer.Write([]string{row.mcc, row.mnc, country.iso, country.country, row.operator, row.carrier}); err != nil {
+				return err
+			}
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return err
+	}
+	return os.WriteFile(path, buffer.Bytes(), 0644)
+}
+
+func generate(mccRows []mccRow, mncRows []mncRow) ([]byte, error) {
+	var iso = make(map[string]string, len(mccRows))
+	var buffer bytes.Buffer
+	buffer.WriteString("// Code generated by mccmncgen from mccMnc.csv. DO NOT EDIT.\n\n")
+	buffer.WriteString("package constants\n\n")
+	buffer.WriteString("// MccList: mcc -> lower case ISO 3166-1 alpha-2\n")
+	buffer.WriteString("var MccList = map[int]string{\n")
+	for _, row := range mccRows {
+		iso[row.mcc] = row.iso
+		mcc, _ := strconv.Atoi(row.mcc)
+		fmt.Fprintf(&buffer, "\t%d: %q, //%s\n", mcc, strings.ToLower(row.iso), row.country)
+	}
+	buffer.WriteString("}\n\n")
+	buffer.WriteString("// MccMncList: mcc + mnc -> operator\n")
+	buffer.WriteString("var MccMncList = map[string]MccMnc{\n")
+	for _, row := range mncRows {
+		fmt.Fprintf(&buffer, "\t%q: {Mcc: %q, Mnc: %q, Country: %q, Operator: %q, HuaweiCarrier: %s},\n",
+			row.mcc+row.mnc, row.mcc, row.mnc, iso[row.mcc], row.operator, row.carrier)
+	}
+	buffer.WriteString("}\n")
+	return format.Source(buffer.Bytes())
+}